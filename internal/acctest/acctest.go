@@ -102,7 +102,7 @@ var Provider *schema.Provider
 var testAccProviderConfigure sync.Once
 
 func init() {
-	Provider = provider.Provider()
+	Provider = vcrOfflineProvider(emulatorProvider(provider.Provider()))
 
 	Providers = map[string]*schema.Provider{
 		ProviderName: Provider,
@@ -111,11 +111,15 @@ func init() {
 	// Always allocate a new provider instance each invocation, otherwise gRPC
 	// ProviderConfigure() can overwrite configuration during concurrent testing.
 	ProviderFactories = map[string]func() (*schema.Provider, error){
-		ProviderName: func() (*schema.Provider, error) { return provider.Provider(), nil }, //nolint:unparam
+		ProviderName: func() (*schema.Provider, error) { return emulatorProvider(provider.Provider()), nil }, //nolint:unparam
 	}
 
 	ProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
 		ProviderName: func() (tfprotov5.ProviderServer, error) {
+			if EmulatorMode() {
+				return emulatorProvider(provider.Provider()).GRPCProvider(), nil
+			}
+
 			providerServerFactory, err := provider.ProtoV5ProviderServerFactory(context.Background())
 
 			if err != nil {
@@ -132,7 +136,7 @@ func factoriesInit(providers *[]*schema.Provider, providerNames []string) map[st
 	var factories = make(map[string]func() (*schema.Provider, error), len(providerNames))
 
 	for _, name := range providerNames {
-		p := provider.Provider()
+		p := emulatorProvider(provider.Provider())
 
 		factories[name] = func() (*schema.Provider, error) { //nolint:unparam
 			return p, nil
@@ -208,6 +212,10 @@ func PreCheck(t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
+		if EmulatorMode() || VCRMode() == VCRModeReplay {
+			preCheckPlaceholderCredentials()
+		}
+
 		conns.FailIfAllEnvVarEmpty(t, []string{conns.EnvVarProfile, conns.EnvVarAccessKeyId, conns.EnvVarContainerCredentialsFullURI}, "credentials for running acceptance testing")
 
 		if os.Getenv(conns.EnvVarAccessKeyId) != "" {
@@ -565,6 +573,10 @@ func PrimaryInstanceState(s *terraform.State, name string) (*terraform.InstanceS
 // AccountID returns the account ID of Provider
 // Must be used within a resource.TestCheckFunc
 func AccountID() string {
+	if VCRMode() == VCRModeReplay {
+		return VCRScrubbedAccountID
+	}
+
 	return providerAccountID(Provider)
 }
 
@@ -617,6 +629,8 @@ func thirdRegionPartition() string {
 }
 
 func PreCheckAlternateAccount(t *testing.T) {
+	PreCheckEmulatorCapabilities(t, EmulatorCapabilityAlternateAccount)
+
 	conns.SkipIfAllEnvVarEmpty(t, []string{conns.EnvVarAlternateProfile, conns.EnvVarAlternateAccessKeyId}, "credentials for running acceptance testing in alternate AWS account")
 
 	if os.Getenv(conns.EnvVarAlternateAccessKeyId) != "" {
@@ -633,6 +647,8 @@ func PreCheckPartitionHasService(serviceId string, t *testing.T) {
 }
 
 func PreCheckMultipleRegion(t *testing.T, regions int) {
+	PreCheckEmulatorCapabilities(t, EmulatorCapabilityMultipleRegion)
+
 	if Region() == AlternateRegion() {
 		t.Fatalf("%s and %s must be set to different values for acceptance tests", conns.EnvVarDefaultRegion, conns.EnvVarAlternateRegion)
 	}
@@ -699,6 +715,8 @@ func PreCheckPartitionNot(t *testing.T, partitions ...string) {
 }
 
 func PreCheckOrganizationsAccount(t *testing.T) {
+	PreCheckEmulatorCapabilities(t, EmulatorCapabilityOrganizations)
+
 	_, err := tforganizations.FindOrganization(Provider.Meta().(*conns.AWSClient).OrganizationsConn)
 
 	if tfresource.NotFound(err) {
@@ -713,6 +731,8 @@ func PreCheckOrganizationsAccount(t *testing.T) {
 }
 
 func PreCheckOrganizationsEnabled(t *testing.T) {
+	PreCheckEmulatorCapabilities(t, EmulatorCapabilityOrganizations)
+
 	_, err := tforganizations.FindOrganization(Provider.Meta().(*conns.AWSClient).OrganizationsConn)
 
 	if tfresource.NotFound(err) {
@@ -725,6 +745,8 @@ func PreCheckOrganizationsEnabled(t *testing.T) {
 }
 
 func PreCheckOrganizationManagementAccount(t *testing.T) {
+	PreCheckEmulatorCapabilities(t, EmulatorCapabilityOrganizations)
+
 	organization, err := tforganizations.FindOrganization(Provider.Meta().(*conns.AWSClient).OrganizationsConn)
 
 	if err != nil {
@@ -1076,6 +1098,10 @@ func ErrorCheck(t *testing.T, endpointIDs ...string) resource.ErrorCheckFunc {
 			t.Skipf("skipping test for %s/%s: %s", Partition(), Region(), err.Error())
 		}
 
+		if EmulatorMode() && errorCheckEmulator(err) {
+			t.Skipf("skipping test for AWS API emulator (%s): %s", EmulatorEndpoint(), err.Error())
+		}
+
 		return err
 	}
 }
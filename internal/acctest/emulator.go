@@ -0,0 +1,166 @@
+package acctest
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Capabilities that a local AWS API emulator may or may not implement.
+// Acceptance tests declare the capabilities they require via PreCheckEmulatorCapabilities
+// and emulators advertise the capabilities they support via TF_ACC_EMULATOR_CAPABILITIES.
+const (
+	// Resources are created in, or shared with, a second AWS account
+	EmulatorCapabilityAlternateAccount = "alternate-account"
+
+	// Resources are created in more than one AWS region
+	EmulatorCapabilityMultipleRegion = "multiple-region"
+
+	// The test account is a member of an AWS Organization
+	EmulatorCapabilityOrganizations = "organizations"
+
+	// IAM policies are evaluated and enforced
+	EmulatorCapabilityIAMEnforcement = "iam-enforcement"
+
+	// Resources transition through asynchronous lifecycle states (e.g. pending -> available)
+	EmulatorCapabilityAsyncLifecycle = "async-lifecycle"
+)
+
+const (
+	placeholderAccessKeyID     = "test"
+	placeholderSecretAccessKey = "test"
+)
+
+// EmulatorEndpoint returns the local AWS API emulator endpoint URL, if any.
+func EmulatorEndpoint() string {
+	return os.Getenv(conns.EnvVarAccEmulator)
+}
+
+// EmulatorMode returns whether acceptance tests are running against a local AWS API emulator.
+func EmulatorMode() bool {
+	return EmulatorEndpoint() != ""
+}
+
+// EmulatorCapabilities returns the capabilities advertised for the local AWS API emulator.
+func EmulatorCapabilities() []string {
+	var capabilities []string
+
+	for _, v := range strings.Split(os.Getenv(conns.EnvVarAccEmulatorCapabilities), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			capabilities = append(capabilities, v)
+		}
+	}
+
+	return capabilities
+}
+
+// PreCheckEmulatorUnsupported skips the test when running against a local AWS API emulator.
+func PreCheckEmulatorUnsupported(t *testing.T, reason string) {
+	if EmulatorMode() {
+		t.Skipf("skipping test; not supported by AWS API emulator (%s): %s", EmulatorEndpoint(), reason)
+	}
+}
+
+// PreCheckEmulatorCapabilities skips the test when running against a local AWS API emulator
+// that does not advertise all of the specified capabilities.
+// Service capabilities can be declared using the names package constants, e.g. names.EC2.
+func PreCheckEmulatorCapabilities(t *testing.T, capabilities ...string) {
+	if !EmulatorMode() {
+		return
+	}
+
+	supported := make(map[string]struct{})
+	for _, v := range EmulatorCapabilities() {
+		supported[v] = struct{}{}
+	}
+
+	for _, capability := range capabilities {
+		if _, ok := supported[capability]; !ok {
+			t.Skipf("skipping test; AWS API emulator (%s) does not support %q. Set %s to enable", EmulatorEndpoint(), capability, conns.EnvVarAccEmulatorCapabilities)
+		}
+	}
+}
+
+// preCheckPlaceholderCredentials sets placeholder static credentials, if none are configured,
+// for running against a local AWS API emulator or replaying recorded interactions.
+func preCheckPlaceholderCredentials() {
+	if os.Getenv(conns.EnvVarProfile) == "" && os.Getenv(conns.EnvVarAccessKeyId) == "" {
+		os.Setenv(conns.EnvVarAccessKeyId, placeholderAccessKeyID)
+		os.Setenv(conns.EnvVarSecretAccessKey, placeholderSecretAccessKey)
+	}
+}
+
+// emulatorProvider wraps the provider's configuration so that, when running against
+// a local AWS API emulator, every service endpoint is overridden and credential,
+// region and metadata API validation is skipped.
+func emulatorProvider(p *schema.Provider) *schema.Provider {
+	endpoint := EmulatorEndpoint()
+
+	if endpoint == "" {
+		return p
+	}
+
+	configure := p.ConfigureContextFunc
+
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		if err := configureEmulator(d, endpoint); err != nil {
+			return nil, diag.Errorf("error configuring AWS API emulator (%s): %s", endpoint, err)
+		}
+
+		return configure(ctx, d)
+	}
+
+	return p
+}
+
+func configureEmulator(d *schema.ResourceData, endpoint string) error {
+	endpoints := make(map[string]interface{})
+	for _, alias := range names.Aliases() {
+		endpoints[alias] = endpoint
+	}
+
+	if err := d.Set("endpoints", []interface{}{endpoints}); err != nil {
+		return err
+	}
+
+	for k, v := range map[string]interface{}{
+		"s3_use_path_style":           true,
+		"skip_credentials_validation": true,
+		"skip_get_ec2_platforms":      true,
+		"skip_metadata_api_check":     "true",
+		"skip_region_validation":      true,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// errorCheckEmulator returns whether an error indicates an API operation that
+// the local AWS API emulator does not implement.
+//
+// NOTE: This function cannot use the standard tfawserr helpers
+// as it is receiving error strings from the SDK testing framework,
+// not actual error types from the resource logic.
+func errorCheckEmulator(err error) bool {
+	for _, message := range []string{
+		"not yet implemented",
+		"NotImplemented",
+		"StatusCode: 501",
+		"status code: 501",
+	} {
+		if strings.Contains(err.Error(), message) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,108 @@
+package acctest
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestEmulatorCapabilities(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		EnvValue string
+		Expected []string
+	}{
+		{
+			Name:     "empty",
+			EnvValue: "",
+			Expected: nil,
+		},
+		{
+			Name:     "single",
+			EnvValue: EmulatorCapabilityMultipleRegion,
+			Expected: []string{EmulatorCapabilityMultipleRegion},
+		},
+		{
+			Name:     "multiple with whitespace",
+			EnvValue: " multiple-region, ,organizations ",
+			Expected: []string{EmulatorCapabilityMultipleRegion, EmulatorCapabilityOrganizations},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Setenv(conns.EnvVarAccEmulatorCapabilities, testCase.EnvValue)
+
+			if got, want := EmulatorCapabilities(), testCase.Expected; !reflect.DeepEqual(got, want) {
+				t.Errorf("got %v, expected %v", got, want)
+			}
+		})
+	}
+}
+
+func TestErrorCheckEmulator(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Err      error
+		Expected bool
+	}{
+		{
+			Name:     "LocalStack not implemented",
+			Err:      errors.New("InternalFailure: API action 'CreateStorageLensConfiguration' for service 's3control' not yet implemented or pro feature"),
+			Expected: true,
+		},
+		{
+			Name:     "moto not implemented",
+			Err:      errors.New("NotImplementedError: The create_export_task action has not been implemented"),
+			Expected: true,
+		},
+		{
+			Name:     "other",
+			Err:      errors.New("InvalidParameterValue: invalid CIDR block"),
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got, want := errorCheckEmulator(testCase.Err), testCase.Expected; got != want {
+				t.Errorf("got %t, expected %t", got, want)
+			}
+		})
+	}
+}
+
+func TestConfigureEmulator(t *testing.T) {
+	const endpoint = "http://localhost:4566"
+
+	d := schema.TestResourceDataRaw(t, provider.Provider().Schema, map[string]interface{}{})
+
+	if err := configureEmulator(d, endpoint); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, key := range []string{"s3_use_path_style", "skip_credentials_validation", "skip_get_ec2_platforms", "skip_region_validation"} {
+		if !d.Get(key).(bool) {
+			t.Errorf("expected %s to be true", key)
+		}
+	}
+
+	endpoints := d.Get("endpoints").(*schema.Set).List()
+
+	if got, want := len(endpoints), 1; got != want {
+		t.Fatalf("got %d endpoints blocks, expected %d", got, want)
+	}
+
+	m := endpoints[0].(map[string]interface{})
+
+	for _, alias := range names.Aliases() {
+		if got, want := m[alias], endpoint; got != want {
+			t.Errorf("endpoint %s: got %q, expected %q", alias, got, want)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package fake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceData returns a ResourceData for the resource or data source from raw configuration values.
+func ResourceData(t *testing.T, r *schema.Resource, raw map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+
+	return schema.TestResourceDataRaw(t, r.Schema, raw)
+}
+
+// Create runs the resource's create function.
+func Create(ctx context.Context, r *schema.Resource, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	switch {
+	case r.CreateContext != nil:
+		return r.CreateContext(ctx, d, meta)
+	case r.CreateWithoutTimeout != nil:
+		return r.CreateWithoutTimeout(ctx, d, meta)
+	default:
+		return diag.FromErr(r.Create(d, meta)) //nolint:staticcheck // Legacy CRUD functions are still in use.
+	}
+}
+
+// Read runs the resource's or data source's read function.
+func Read(ctx context.Context, r *schema.Resource, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	switch {
+	case r.ReadContext != nil:
+		return r.ReadContext(ctx, d, meta)
+	case r.ReadWithoutTimeout != nil:
+		return r.ReadWithoutTimeout(ctx, d, meta)
+	default:
+		return diag.FromErr(r.Read(d, meta)) //nolint:staticcheck // Legacy CRUD functions are still in use.
+	}
+}
+
+// Update runs the resource's update function.
+func Update(ctx context.Context, r *schema.Resource, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	switch {
+	case r.UpdateContext != nil:
+		return r.UpdateContext(ctx, d, meta)
+	case r.UpdateWithoutTimeout != nil:
+		return r.UpdateWithoutTimeout(ctx, d, meta)
+	default:
+		return diag.FromErr(r.Update(d, meta)) //nolint:staticcheck // Legacy CRUD functions are still in use.
+	}
+}
+
+// Delete runs the resource's delete function.
+func Delete(ctx context.Context, r *schema.Resource, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	switch {
+	case r.DeleteContext != nil:
+		return r.DeleteContext(ctx, d, meta)
+	case r.DeleteWithoutTimeout != nil:
+		return r.DeleteWithoutTimeout(ctx, d, meta)
+	default:
+		return diag.FromErr(r.Delete(d, meta)) //nolint:staticcheck // Legacy CRUD functions are still in use.
+	}
+}
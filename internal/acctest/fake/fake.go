@@ -0,0 +1,209 @@
+// Package fake provides in-memory AWS service clients for unit testing resource and data source logic
+// without AWS credentials or network access.
+//
+// Service clients are real AWS SDK clients whose API operations are served by a stub instead of
+// being sent over HTTP. A stub for an AWS SDK for Go v1 service typically embeds the service's
+// ...iface interface and implements only the operations under test:
+//
+//	type ec2Stub struct {
+//		ec2iface.EC2API
+//	}
+//
+//	func (ec2Stub) DescribeVpcs(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
+//		return &ec2.DescribeVpcsOutput{...}, nil
+//	}
+//
+//	client := fake.Client()
+//	client.EC2Conn = fake.ServiceV1(ec2.New, ec2Stub{}).(*ec2.EC2)
+//
+// Request parameter validation, pagination and waiters behave as for the real service.
+// Calling an operation the stub does not implement returns an UnimplementedOperation error.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddlewarev2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+const (
+	AccountID = "123456789012"
+	DNSSuffix = "amazonaws.com"
+	Partition = "aws"
+	Region    = "us-west-2"
+)
+
+// ErrCodeUnimplementedOperation is returned for API operations not implemented by a stub.
+const ErrCodeUnimplementedOperation = "UnimplementedOperation"
+
+// Client returns an AWSClient for unit testing.
+// Service clients are nil until set from ServiceV1 or ServiceV2.
+func Client() *conns.AWSClient {
+	return &conns.AWSClient{
+		AccountID:         AccountID,
+		DefaultTagsConfig: &tftags.DefaultConfig{},
+		DNSSuffix:         DNSSuffix,
+		IgnoreTagsConfig:  &tftags.IgnoreConfig{},
+		Partition:         Partition,
+		Region:            Region,
+		ReverseDNSPrefix:  conns.ReverseDNS(DNSSuffix),
+		Session:           newSession(),
+		SupportedPlatforms: []string{
+			"VPC",
+		},
+		TerraformVersion: "0.0.0-fake",
+	}
+}
+
+func newSession() *session.Session {
+	return session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("fake", "fake", ""),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String(Region),
+	}))
+}
+
+// ServiceV1 returns an AWS SDK for Go v1 service client whose API operations are served by stub.
+// newFn is the service package's New function, e.g. ec2.New.
+// stub's methods are matched to API operations by name, e.g. DescribeVpcs(*ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error).
+func ServiceV1(newFn interface{}, stub interface{}) interface{} {
+	conn := reflect.ValueOf(newFn).Call([]reflect.Value{reflect.ValueOf(newSession())})[0]
+	c := conn.Elem().FieldByName("Client").Interface().(*client.Client)
+
+	// Only parameter validation, building and signing remain.
+	c.Handlers.Send.Clear()
+	c.Handlers.ValidateResponse.Clear()
+	c.Handlers.Unmarshal.Clear()
+	c.Handlers.UnmarshalMeta.Clear()
+	c.Handlers.UnmarshalError.Clear()
+	c.Handlers.Retry.Clear()
+	c.Handlers.AfterRetry.Clear()
+
+	c.Handlers.Send.PushBack(func(r *request.Request) {
+		output, err := call(stub, r.Operation.Name, reflect.ValueOf(r.Params))
+
+		if err != nil {
+			r.Error = err
+			return
+		}
+
+		if output.IsNil() {
+			return
+		}
+
+		reflect.ValueOf(r.Data).Elem().Set(output.Elem())
+	})
+
+	return conn.Interface()
+}
+
+// ServiceV2 returns an AWS SDK for Go v2 service client whose API operations are served by stub.
+// newFromConfigFn is the service package's NewFromConfig function, e.g. kendra.NewFromConfig.
+// stub's methods are matched to API operations by name, e.g.
+// DescribeIndex(context.Context, *kendra.DescribeIndexInput, ...func(*kendra.Options)) (*kendra.DescribeIndexOutput, error).
+func ServiceV2(newFromConfigFn interface{}, stub interface{}) interface{} {
+	cfg := awsv2.Config{
+		Credentials: awsv2.CredentialsProviderFunc(func(context.Context) (awsv2.Credentials, error) {
+			return awsv2.Credentials{AccessKeyID: "fake", SecretAccessKey: "fake", Source: "fake"}, nil
+		}),
+		Region: Region,
+		APIOptions: []func(*middleware.Stack) error{
+			func(stack *middleware.Stack) error {
+				// Added after the operation's parameter validation.
+				return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("FakeService", func(ctx context.Context, in middleware.InitializeInput, _ middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+					var metadata middleware.Metadata
+
+					output, err := call(stub, awsmiddlewarev2.GetOperationName(ctx), reflect.ValueOf(ctx), reflect.ValueOf(in.Parameters))
+
+					if err != nil {
+						return middleware.InitializeOutput{}, metadata, err
+					}
+
+					return middleware.InitializeOutput{Result: output.Interface()}, metadata, nil
+				}), middleware.After)
+			},
+		},
+	}
+
+	return reflect.ValueOf(newFromConfigFn).Call([]reflect.Value{reflect.ValueOf(cfg)})[0].Interface()
+}
+
+// call invokes the stub method implementing the named API operation.
+func call(stub interface{}, operation string, args ...reflect.Value) (reflect.Value, error) {
+	method := reflect.ValueOf(stub).MethodByName(operation)
+
+	if !method.IsValid() || promotedFromNilInterface(stub, operation) {
+		return reflect.Value{}, awserr.New(ErrCodeUnimplementedOperation, fmt.Sprintf("%s is not implemented by %T", operation, stub), nil)
+	}
+
+	results := method.Call(args)
+
+	if v := results[1].Interface(); v != nil {
+		return reflect.Value{}, v.(error)
+	}
+
+	return results[0], nil
+}
+
+// promotedFromNilInterface returns whether the stub method implementing the named API operation is promoted
+// from a nil embedded interface, e.g. ec2iface.EC2API, and so would panic if called.
+func promotedFromNilInterface(stub interface{}, operation string) bool {
+	t, v := reflect.TypeOf(stub), reflect.ValueOf(stub)
+
+	if t.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return false
+		}
+
+		// Methods with value receivers are otherwise seen through their pointer receiver wrappers.
+		if _, ok := t.Elem().MethodByName(operation); ok {
+			t = t.Elem()
+		}
+
+		v = v.Elem()
+	}
+
+	m, ok := t.MethodByName(operation)
+
+	if !ok {
+		return false
+	}
+
+	// Promoted methods are compiler-generated wrappers.
+	pc := m.Func.Pointer()
+
+	if file, _ := runtime.FuncForPC(pc).FileLine(pc); file != "<autogenerated>" {
+		return false
+	}
+
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+
+		if !field.Anonymous || field.Type.Kind() != reflect.Interface || !v.Field(i).IsNil() {
+			continue
+		}
+
+		if _, ok := field.Type.MethodByName(operation); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,80 @@
+package fake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/kendra"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+type sqsStub struct {
+	sqsiface.SQSAPI
+}
+
+func (sqsStub) GetQueueUrl(input *sqs.GetQueueUrlInput) (*sqs.GetQueueUrlOutput, error) {
+	return &sqs.GetQueueUrlOutput{QueueUrl: aws.String("https://sqs.us-west-2.amazonaws.com/" + AccountID + "/" + aws.StringValue(input.QueueName))}, nil
+}
+
+func TestServiceV1(t *testing.T) {
+	conn := ServiceV1(sqs.New, sqsStub{}).(*sqs.SQS)
+
+	output, err := conn.GetQueueUrl(&sqs.GetQueueUrlInput{QueueName: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(output.QueueUrl), "https://sqs.us-west-2.amazonaws.com/123456789012/test"; got != want {
+		t.Errorf("got %q, expected %q", got, want)
+	}
+
+	// Parameter validation still applies.
+	if _, err := conn.GetQueueUrl(&sqs.GetQueueUrlInput{}); !tfawserr.ErrCodeEquals(err, "InvalidParameter") {
+		t.Errorf("expected InvalidParameter error, got %v", err)
+	}
+
+	// Operations promoted from the nil embedded interface are not implemented.
+	if _, err := conn.ListQueues(&sqs.ListQueuesInput{}); !tfawserr.ErrCodeEquals(err, ErrCodeUnimplementedOperation) {
+		t.Errorf("expected %s error, got %v", ErrCodeUnimplementedOperation, err)
+	}
+}
+
+type sqsPanicStub struct {
+	sqsiface.SQSAPI
+}
+
+func (*sqsPanicStub) GetQueueUrl(input *sqs.GetQueueUrlInput) (*sqs.GetQueueUrlOutput, error) {
+	panic("stub bug")
+}
+
+func TestServiceV1_panic(t *testing.T) {
+	conn := ServiceV1(sqs.New, &sqsPanicStub{}).(*sqs.SQS)
+
+	// Operations promoted from the nil embedded interface are not implemented.
+	if _, err := conn.ListQueues(&sqs.ListQueuesInput{}); !tfawserr.ErrCodeEquals(err, ErrCodeUnimplementedOperation) {
+		t.Errorf("expected %s error, got %v", ErrCodeUnimplementedOperation, err)
+	}
+
+	// Panics in implemented operations are not hidden.
+	defer func() {
+		if r := recover(); r != "stub bug" {
+			t.Errorf("expected stub panic, got %v", r)
+		}
+	}()
+
+	conn.GetQueueUrl(&sqs.GetQueueUrlInput{QueueName: aws.String("test")}) //nolint:errcheck
+
+	t.Error("expected panic")
+}
+
+func TestServiceV2(t *testing.T) {
+	conn := ServiceV2(kendra.NewFromConfig, struct{}{}).(*kendra.Client)
+
+	if _, err := conn.ListIndices(context.Background(), &kendra.ListIndicesInput{}); !tfawserr.ErrCodeEquals(err, ErrCodeUnimplementedOperation) {
+		t.Errorf("expected %s error, got %v", ErrCodeUnimplementedOperation, err)
+	}
+}
@@ -0,0 +1,737 @@
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+const (
+	// VCRModeRecord captures every AWS API interaction of a test into its cassette.
+	VCRModeRecord = "RECORD"
+
+	// VCRModeReplay serves every AWS API interaction of a test from its cassette without network access.
+	VCRModeReplay = "REPLAY"
+)
+
+// VCRScrubbedAccountID replaces the AWS account ID in recorded interactions.
+const VCRScrubbedAccountID = "123456789012"
+
+// VCRMode returns the mode for recorded HTTP interactions, if any.
+func VCRMode() string {
+	switch v := strings.ToUpper(os.Getenv(conns.EnvVarAccVCRMode)); v {
+	case VCRModeRecord, VCRModeReplay:
+		return v
+	default:
+		return ""
+	}
+}
+
+// VCREnabled returns whether acceptance tests are recording or replaying HTTP interactions.
+func VCREnabled() bool {
+	return VCRMode() != ""
+}
+
+// ParallelTest wraps resource.ParallelTest, recording or replaying the test's AWS API interactions if enabled.
+func ParallelTest(t *testing.T, c resource.TestCase) {
+	t.Helper()
+
+	resource.ParallelTest(t, vcrTestCase(t, c))
+}
+
+// Test wraps resource.Test, recording or replaying the test's AWS API interactions if enabled.
+func Test(t *testing.T, c resource.TestCase) {
+	t.Helper()
+
+	resource.Test(t, vcrTestCase(t, c))
+}
+
+// ProviderMeta returns the AWS client to be used by test check functions.
+// When recording or replaying, this is the client of the test's own provider instance
+// so that its API interactions are captured in, or served from, the test's cassette.
+func ProviderMeta(t *testing.T) *conns.AWSClient {
+	if VCREnabled() {
+		if cassette := vcrCassettes.get(t.Name()); cassette != nil {
+			meta, err := cassette.providerMeta()
+
+			if err != nil {
+				t.Fatalf("error configuring VCR provider: %s", err)
+			}
+
+			return meta
+		}
+	}
+
+	return Provider.Meta().(*conns.AWSClient)
+}
+
+// RandomWithPrefix is used to generate a unique name with a prefix.
+// When recording or replaying, names are deterministic for each test.
+func RandomWithPrefix(t *testing.T, prefix string) string {
+	if !VCREnabled() {
+		return sdkacctest.RandomWithPrefix(prefix)
+	}
+
+	return fmt.Sprintf("%s-%d", prefix, vcrRandInt(t))
+}
+
+// RandInt generates a random integer.
+// When recording or replaying, integers are deterministic for each test.
+func RandInt(t *testing.T) int {
+	if !VCREnabled() {
+		return sdkacctest.RandInt()
+	}
+
+	return vcrRandInt(t)
+}
+
+// RandString generates a random alphanumeric string of the length specified.
+// When recording or replaying, strings are deterministic for each test.
+func RandString(t *testing.T, n int) string {
+	if !VCREnabled() {
+		return sdkacctest.RandString(n)
+	}
+
+	vcrRandomSources.Lock()
+	defer vcrRandomSources.Unlock()
+
+	r := vcrRandomSources.source(t)
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = sdkacctest.CharSetAlphaNum[r.Intn(len(sdkacctest.CharSetAlphaNum))]
+	}
+
+	return string(b)
+}
+
+// vcrRandomSources holds per-test pseudo-random sources seeded from the test name.
+var vcrRandomSources = &vcrRandomSourceRegistry{}
+
+type vcrRandomSourceRegistry struct {
+	sync.Mutex
+	m map[string]*rand.Rand
+}
+
+// vcrTest is the subset of testing.TB used to scope pseudo-random sources to a test.
+type vcrTest interface {
+	Cleanup(func())
+	Name() string
+}
+
+// source returns the test's pseudo-random source. The caller must hold the lock.
+// The source is discarded when the test completes so that each run of the test, e.g. with -count, generates the same values.
+func (s *vcrRandomSourceRegistry) source(t vcrTest) *rand.Rand {
+	if s.m == nil {
+		s.m = make(map[string]*rand.Rand)
+	}
+
+	name := t.Name()
+	r, ok := s.m[name]
+
+	if !ok {
+		h := fnv.New64a()
+		h.Write([]byte(name))
+		r = rand.New(rand.NewSource(int64(h.Sum64()))) //nolint:gosec // Deterministic names are the point.
+		s.m[name] = r
+
+		t.Cleanup(func() {
+			s.Lock()
+			defer s.Unlock()
+
+			delete(s.m, name)
+		})
+	}
+
+	return r
+}
+
+func vcrRandInt(t vcrTest) int {
+	vcrRandomSources.Lock()
+	defer vcrRandomSources.Unlock()
+
+	return vcrRandomSources.source(t).Int()
+}
+
+// vcrOfflineProvider configures the shared Provider instance so that
+// it makes no AWS API calls during configuration when replaying.
+func vcrOfflineProvider(p *schema.Provider) *schema.Provider {
+	if VCRMode() != VCRModeReplay {
+		return p
+	}
+
+	configure := p.ConfigureContextFunc
+
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		for k, v := range map[string]interface{}{
+			"skip_credentials_validation": true,
+			"skip_get_ec2_platforms":      true,
+			"skip_metadata_api_check":     "true",
+			"skip_requesting_account_id":  true,
+		} {
+			if err := d.Set(k, v); err != nil {
+				return nil, diag.FromErr(err)
+			}
+		}
+
+		return configure(ctx, d)
+	}
+
+	return p
+}
+
+// vcrTestCase binds every provider instance of the test case to the test's cassette.
+func vcrTestCase(t *testing.T, c resource.TestCase) resource.TestCase {
+	if !VCREnabled() {
+		return c
+	}
+
+	cassette, err := vcrCassettes.open(t.Name(), VCRMode())
+
+	if err != nil {
+		t.Fatalf("error opening VCR cassette: %s", err)
+	}
+
+	t.Cleanup(func() {
+		vcrCassettes.close(t.Name())
+
+		if t.Skipped() {
+			return
+		}
+
+		if err := cassette.save(); err != nil {
+			t.Errorf("error saving VCR cassette: %s", err)
+		}
+	})
+
+	if c.ProviderFactories != nil {
+		providerFactories := make(map[string]func() (*schema.Provider, error), len(c.ProviderFactories))
+
+		for name, factory := range c.ProviderFactories {
+			factory := factory
+
+			providerFactories[name] = func() (*schema.Provider, error) {
+				p, err := factory()
+
+				if err != nil {
+					return nil, err
+				}
+
+				return cassette.provider(p), nil
+			}
+		}
+
+		c.ProviderFactories = providerFactories
+	}
+
+	if c.ProtoV5ProviderFactories != nil {
+		protoV5ProviderFactories := make(map[string]func() (tfprotov5.ProviderServer, error), len(c.ProtoV5ProviderFactories))
+
+		for name, factory := range c.ProtoV5ProviderFactories {
+			factory := factory
+
+			protoV5ProviderFactories[name] = func() (tfprotov5.ProviderServer, error) {
+				server, err := factory()
+
+				if err != nil {
+					return nil, err
+				}
+
+				return &vcrProviderServer{
+					ProviderServer: server,
+					cassette:       cassette,
+				}, nil
+			}
+		}
+
+		c.ProtoV5ProviderFactories = protoV5ProviderFactories
+	}
+
+	return c
+}
+
+// vcrCassettes holds the cassettes of running tests.
+var vcrCassettes = &vcrCassetteRegistry{}
+
+type vcrCassetteRegistry struct {
+	mu        sync.Mutex
+	cassettes map[string]*vcrCassette
+}
+
+func (r *vcrCassetteRegistry) open(name, mode string) (*vcrCassette, error) {
+	dir := os.Getenv(conns.EnvVarAccVCRPath)
+
+	if dir == "" {
+		return nil, fmt.Errorf("environment variable %s must be set when %s is set", conns.EnvVarAccVCRPath, conns.EnvVarAccVCRMode)
+	}
+
+	cassette := &vcrCassette{
+		mode:      mode,
+		path:      filepath.Join(dir, strings.ReplaceAll(name, "/", "_")+".json"),
+		providers: make(map[*schema.Provider]struct{}),
+	}
+
+	if mode == VCRModeReplay {
+		if err := cassette.load(); err != nil {
+			return nil, err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cassettes == nil {
+		r.cassettes = make(map[string]*vcrCassette)
+	}
+
+	r.cassettes[name] = cassette
+
+	return cassette, nil
+}
+
+func (r *vcrCassetteRegistry) get(name string) *vcrCassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cassettes[name]
+}
+
+func (r *vcrCassetteRegistry) close(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.cassettes, name)
+}
+
+type vcrRequest struct {
+	Method    string `json:"method"`
+	URL       string `json:"url"`
+	Operation string `json:"operation,omitempty"`
+	Body      string `json:"body,omitempty"`
+}
+
+type vcrResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+type vcrInteraction struct {
+	Request  vcrRequest  `json:"request"`
+	Response vcrResponse `json:"response"`
+
+	used bool
+	// normalizedBody caches the normalized request body.
+	normalizedBody *string
+}
+
+// matches returns whether the interaction was recorded for an equivalent request.
+// normalizedBody is the request's body as returned by vcrNormalizeBody.
+func (v *vcrInteraction) matches(request vcrRequest, normalizedBody string) bool {
+	if v.Request.Method != request.Method || v.Request.URL != request.URL || v.Request.Operation != request.Operation {
+		return false
+	}
+
+	if v.normalizedBody == nil {
+		body := vcrNormalizeBody(v.Request.Body)
+		v.normalizedBody = &body
+	}
+
+	return *v.normalizedBody == normalizedBody
+}
+
+// vcrCassette holds the recorded AWS API interactions of a single test.
+type vcrCassette struct {
+	mu           sync.Mutex
+	mode         string
+	path         string
+	interactions []*vcrInteraction
+	metas        []*conns.AWSClient
+	providers    map[*schema.Provider]struct{}
+}
+
+func (c *vcrCassette) load() error {
+	b, err := os.ReadFile(c.path)
+
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", c.path, err)
+	}
+
+	if err := json.Unmarshal(b, &c.interactions); err != nil {
+		return fmt.Errorf("decoding %s: %w", c.path, err)
+	}
+
+	return nil
+}
+
+func (c *vcrCassette) save() error {
+	if c.mode != VCRModeRecord {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	replacer := c.scrubber()
+
+	for _, v := range c.interactions {
+		v.Request.URL = replacer.Replace(v.Request.URL)
+		v.Request.Body = replacer.Replace(v.Request.Body)
+		v.Response.Body = replacer.Replace(v.Response.Body)
+
+		for k, vs := range v.Response.Header {
+			for i := range vs {
+				vs[i] = replacer.Replace(vs[i])
+			}
+			v.Response.Header[k] = vs
+		}
+	}
+
+	b, err := json.MarshalIndent(c.interactions, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("encoding %s: %w", c.path, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return fmt.Errorf("creating %s: %w", filepath.Dir(c.path), err)
+	}
+
+	if err := os.WriteFile(c.path, b, 0644); err != nil { //nolint:gosec // Cassettes contain no secrets once scrubbed.
+		return fmt.Errorf("writing %s: %w", c.path, err)
+	}
+
+	return nil
+}
+
+// VCRRedacted replaces credentials in recorded interactions.
+const VCRRedacted = "REDACTED"
+
+// vcrSecretPatterns match the secret values of temporary and long-term credentials returned in
+// XML (Query protocol) and JSON response bodies, e.g. by STS AssumeRole or IAM CreateAccessKey.
+var vcrSecretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`<(?:SecretAccessKey|SessionToken)>([^<]+)</`),
+	regexp.MustCompile(`"(?i:secretAccessKey|sessionToken)"\s*:\s*"((?:[^"\\]|\\.)+)"`),
+}
+
+// scrubber returns a replacer for the credentials and account IDs used while recording.
+// The caller must hold the lock.
+func (c *vcrCassette) scrubber() *strings.Replacer {
+	var oldnew []string
+
+	// Request headers, including the signature, are never recorded, but credentials may be
+	// returned by IAM or STS, or echoed in request and response bodies.
+	for _, k := range []string{
+		conns.EnvVarAccessKeyId,
+		conns.EnvVarAlternateAccessKeyId,
+		conns.EnvVarAlternateSecretAccessKey,
+		conns.EnvVarSecretAccessKey,
+		conns.EnvVarSessionToken,
+	} {
+		// Guard against short values corrupting unrelated content.
+		if v := os.Getenv(k); len(v) >= 16 {
+			oldnew = append(oldnew, v, VCRRedacted)
+		}
+	}
+
+	// Secrets returned in responses are replaced wherever they appear, including in later requests.
+	for _, v := range c.interactions {
+		for _, re := range vcrSecretPatterns {
+			for _, m := range re.FindAllStringSubmatch(v.Response.Body, -1) {
+				if m[1] != VCRRedacted {
+					oldnew = append(oldnew, m[1], VCRRedacted)
+				}
+			}
+		}
+	}
+
+	metas := append([]*conns.AWSClient{}, c.metas...)
+
+	// Provider servers do not expose their client, but are configured with the same credentials as the shared Provider.
+	if meta, ok := Provider.Meta().(*conns.AWSClient); ok {
+		metas = append(metas, meta)
+	}
+
+	for _, meta := range metas {
+		if meta.AccountID != "" && meta.AccountID != VCRScrubbedAccountID {
+			oldnew = append(oldnew, meta.AccountID, VCRScrubbedAccountID)
+		}
+	}
+
+	return strings.NewReplacer(oldnew...)
+}
+
+// provider binds the provider's AWS API interactions to the cassette.
+func (c *vcrCassette) provider(p *schema.Provider) *schema.Provider {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Some provider factories return the same instance on each invocation.
+	if _, ok := c.providers[p]; ok {
+		return p
+	}
+
+	c.providers[p] = struct{}{}
+
+	configure := p.ConfigureContextFunc
+
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		if c.mode == VCRModeReplay {
+			if err := d.Set("skip_metadata_api_check", "true"); err != nil {
+				return nil, diag.FromErr(err)
+			}
+		}
+
+		meta, diags := configure(conns.ContextWithHTTPTransportWrapper(ctx, c.transport), d)
+
+		if v, ok := meta.(*conns.AWSClient); ok {
+			c.mu.Lock()
+			c.metas = append(c.metas, v)
+			c.mu.Unlock()
+		}
+
+		return meta, diags
+	}
+
+	return p
+}
+
+// providerMeta returns the AWS client of the first configured provider instance.
+// Provider servers, such as the mux server of ProtoV5ProviderFactories, do not expose their client,
+// so if there is no such instance a dedicated one is bound to the cassette and configured.
+func (c *vcrCassette) providerMeta() (*conns.AWSClient, error) {
+	c.mu.Lock()
+
+	if len(c.metas) > 0 {
+		meta := c.metas[0]
+		c.mu.Unlock()
+
+		return meta, nil
+	}
+
+	c.mu.Unlock()
+
+	p := c.provider(vcrOfflineProvider(emulatorProvider(provider.Provider())))
+
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(nil)); diags.HasError() {
+		return nil, fmt.Errorf("%v", diags)
+	}
+
+	return p.Meta().(*conns.AWSClient), nil
+}
+
+// vcrProviderServer binds the AWS API interactions of a provider server, such as a mux server, to the cassette.
+// The provider reads the cassette's HTTP transport wrapper from the context passed to ConfigureProvider.
+type vcrProviderServer struct {
+	tfprotov5.ProviderServer
+
+	cassette *vcrCassette
+}
+
+func (s *vcrProviderServer) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	return s.ProviderServer.ConfigureProvider(conns.ContextWithHTTPTransportWrapper(ctx, s.cassette.transport), req)
+}
+
+func (c *vcrCassette) transport(next http.RoundTripper) http.RoundTripper {
+	return &vcrTransport{
+		cassette: c,
+		next:     next,
+	}
+}
+
+type vcrTransport struct {
+	cassette *vcrCassette
+	next     http.RoundTripper
+}
+
+func (t *vcrTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	body, err := vcrRequestBody(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	request := vcrRequest{
+		Method:    r.Method,
+		URL:       r.URL.String(),
+		Operation: vcrOperation(r, body),
+		Body:      string(body),
+	}
+
+	if t.cassette.mode == VCRModeReplay {
+		return t.cassette.replay(r, request)
+	}
+
+	response, err := t.next.RoundTrip(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(response.Body)
+	response.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	response.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	header := response.Header.Clone()
+	header.Del("Content-Length")
+	header.Del("Set-Cookie")
+
+	t.cassette.record(&vcrInteraction{
+		Request: request,
+		Response: vcrResponse{
+			StatusCode: response.StatusCode,
+			Header:     header,
+			Body:       string(responseBody),
+		},
+	})
+
+	return response, nil
+}
+
+func (c *vcrCassette) record(interaction *vcrInteraction) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.interactions = append(c.interactions, interaction)
+}
+
+// replay serves the first unused interaction matching the request, including its body.
+// Repeated requests beyond those recorded, such as additional status polling, are served the last match.
+func (c *vcrCassette) replay(r *http.Request, request vcrRequest) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var match *vcrInteraction
+	body := vcrNormalizeBody(request.Body)
+
+	for _, v := range c.interactions {
+		if !v.matches(request, body) {
+			continue
+		}
+
+		match = v
+
+		if !v.used {
+			break
+		}
+	}
+
+	if match == nil {
+		return nil, fmt.Errorf("no recorded interaction in %s for %s %s (%s)", c.path, request.Method, request.URL, request.Operation)
+	}
+
+	match.used = true
+
+	return &http.Response{
+		Body:          io.NopCloser(strings.NewReader(match.Response.Body)),
+		ContentLength: int64(len(match.Response.Body)),
+		Header:        match.Response.Header.Clone(),
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Request:       r,
+		Status:        fmt.Sprintf("%d %s", match.Response.StatusCode, http.StatusText(match.Response.StatusCode)),
+		StatusCode:    match.Response.StatusCode,
+	}, nil
+}
+
+// vcrRequestBody reads the request body, leaving it intact for the next transport.
+func vcrRequestBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	r.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// vcrOperation returns the API operation name for JSON and Query protocol requests.
+// REST protocol requests are identified by method and URL alone.
+func vcrOperation(r *http.Request, body []byte) string {
+	if v := r.Header.Get("X-Amz-Target"); v != "" {
+		return v
+	}
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			return values.Get("Action")
+		}
+	}
+
+	return ""
+}
+
+// vcrIdempotencyTokenParameters are request parameters that the AWS SDKs fill with a new random value on each call.
+var vcrIdempotencyTokenParameters = []string{
+	"ClientRequestToken",
+	"ClientToken",
+	"IdempotencyToken",
+}
+
+// vcrNormalizeBody returns a canonical form of a Query or JSON protocol request body so that
+// equivalent requests match regardless of parameter order or generated idempotency tokens.
+// Other bodies are returned unchanged.
+func vcrNormalizeBody(body string) string {
+	if body == "" {
+		return body
+	}
+
+	var m map[string]interface{}
+
+	if err := json.Unmarshal([]byte(body), &m); err == nil {
+		for _, k := range vcrIdempotencyTokenParameters {
+			delete(m, k)
+		}
+
+		// Map keys are marshaled in sorted order.
+		if b, err := json.Marshal(m); err == nil {
+			return string(b)
+		}
+
+		return body
+	}
+
+	if values, err := url.ParseQuery(body); err == nil && values.Get("Action") != "" {
+		for _, k := range vcrIdempotencyTokenParameters {
+			values.Del(k)
+		}
+
+		// Values are encoded sorted by key.
+		return values.Encode()
+	}
+
+	return body
+}
@@ -0,0 +1,410 @@
+package acctest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestVCROperation(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Header   map[string]string
+		Body     string
+		Expected string
+	}{
+		{
+			Name:     "JSON protocol",
+			Header:   map[string]string{"X-Amz-Target": "Logs_20140328.CreateLogGroup", "Content-Type": "application/x-amz-json-1.1"},
+			Body:     `{"logGroupName":"test"}`,
+			Expected: "Logs_20140328.CreateLogGroup",
+		},
+		{
+			Name:     "Query protocol",
+			Header:   map[string]string{"Content-Type": "application/x-www-form-urlencoded; charset=utf-8"},
+			Body:     "Action=DescribeVpcs&Version=2016-11-15",
+			Expected: "DescribeVpcs",
+		},
+		{
+			Name:     "REST protocol",
+			Header:   map[string]string{},
+			Expected: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "https://example.com/", strings.NewReader(testCase.Body))
+
+			for k, v := range testCase.Header {
+				r.Header.Set(k, v)
+			}
+
+			if got, want := vcrOperation(r, []byte(testCase.Body)), testCase.Expected; got != want {
+				t.Errorf("got %q, expected %q", got, want)
+			}
+		})
+	}
+}
+
+func TestVCRRandomWithPrefix(t *testing.T) {
+	t.Setenv(conns.EnvVarAccVCRMode, VCRModeReplay)
+
+	if got, want := RandomWithPrefix(t, ResourcePrefix), RandomWithPrefix(t, ResourcePrefix); got == want {
+		t.Errorf("expected successive names to differ, got %q twice", got)
+	}
+}
+
+// vcrTestRun simulates a single run of a test.
+type vcrTestRun struct {
+	name     string
+	cleanups []func()
+}
+
+func (r *vcrTestRun) Cleanup(f func()) {
+	r.cleanups = append(r.cleanups, f)
+}
+
+func (r *vcrTestRun) Name() string {
+	return r.name
+}
+
+func (r *vcrTestRun) done() {
+	for i := len(r.cleanups) - 1; i >= 0; i-- {
+		r.cleanups[i]()
+	}
+}
+
+func TestVCRRandomSourcePerRun(t *testing.T) {
+	// Each run of a test, e.g. with -count, generates the same values.
+	run := func() []int {
+		r := &vcrTestRun{name: "TestAccExample_basic"}
+		defer r.done()
+
+		return []int{vcrRandInt(r), vcrRandInt(r)}
+	}
+
+	first, second := run(), run()
+
+	if first[0] != second[0] || first[1] != second[1] {
+		t.Errorf("got %v on second run, expected %v", second, first)
+	}
+
+	vcrRandomSources.Lock()
+	_, ok := vcrRandomSources.m["TestAccExample_basic"]
+	vcrRandomSources.Unlock()
+
+	if ok {
+		t.Error("expected random source to be discarded when the test completed")
+	}
+}
+
+func TestVCRCassetteRecordReplay(t *testing.T) {
+	const accountID = "111122223333"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		io.WriteString(w, "<Account>"+accountID+"</Account>") //nolint:errcheck
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder := &vcrCassette{mode: VCRModeRecord, path: path}
+	recorder.metas = append(recorder.metas, &conns.AWSClient{AccountID: accountID})
+
+	client := &http.Client{Transport: recorder.transport(http.DefaultTransport)}
+
+	body := vcrTestRoundTrip(t, client, server.URL)
+
+	if got, want := body, "<Account>"+accountID+"</Account>"; got != want {
+		t.Errorf("recording: got %q, expected %q", got, want)
+	}
+
+	if err := recorder.save(); err != nil {
+		t.Fatalf("error saving cassette: %s", err)
+	}
+
+	server.Close()
+
+	player := &vcrCassette{mode: VCRModeReplay, path: path}
+
+	if err := player.load(); err != nil {
+		t.Fatalf("error loading cassette: %s", err)
+	}
+
+	client = &http.Client{Transport: player.transport(http.DefaultTransport)}
+
+	// Repeated requests are served the last matching interaction.
+	for i := 0; i < 2; i++ {
+		body = vcrTestRoundTrip(t, client, server.URL)
+
+		if got, want := body, "<Account>"+VCRScrubbedAccountID+"</Account>"; got != want {
+			t.Errorf("replaying: got %q, expected %q", got, want)
+		}
+	}
+
+	r, err := http.NewRequest(http.MethodGet, server.URL+"/unrecorded", nil)
+
+	if err != nil {
+		t.Fatalf("error creating request: %s", err)
+	}
+
+	if _, err := client.Do(r); err == nil {
+		t.Error("expected error for unrecorded interaction")
+	}
+}
+
+func TestVCRCassetteReplayMatchesBody(t *testing.T) {
+	const url = "https://ec2.us-west-2.amazonaws.com/"
+
+	cassette := &vcrCassette{mode: VCRModeReplay}
+
+	for _, v := range []struct {
+		requestBody, responseBody string
+	}{
+		{"Action=DescribeVpcs&Version=2016-11-15&VpcId.1=vpc-1", "vpc-1"},
+		{"Action=DescribeVpcs&Version=2016-11-15&VpcId.1=vpc-2", "vpc-2"},
+		{`{"logGroupNamePrefix":"a","limit":50}`, "a"},
+		{"Action=RunInstances&ClientToken=recorded&Version=2016-11-15", "i-1"},
+	} {
+		cassette.interactions = append(cassette.interactions, &vcrInteraction{
+			Request: vcrRequest{
+				Method: http.MethodPost,
+				URL:    url,
+				Body:   v.requestBody,
+			},
+			Response: vcrResponse{
+				StatusCode: http.StatusOK,
+				Body:       v.responseBody,
+			},
+		})
+	}
+
+	testCases := []struct {
+		Name        string
+		RequestBody string
+		Expected    string
+	}{
+		{
+			Name:        "reordered calls",
+			RequestBody: "Action=DescribeVpcs&Version=2016-11-15&VpcId.1=vpc-2",
+			Expected:    "vpc-2",
+		},
+		{
+			Name:        "Query parameter order",
+			RequestBody: "VpcId.1=vpc-1&Action=DescribeVpcs&Version=2016-11-15",
+			Expected:    "vpc-1",
+		},
+		{
+			Name:        "JSON key order",
+			RequestBody: `{"limit":50,"logGroupNamePrefix":"a"}`,
+			Expected:    "a",
+		},
+		{
+			Name:        "idempotency token",
+			RequestBody: "Action=RunInstances&ClientToken=replayed&Version=2016-11-15",
+			Expected:    "i-1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, url, nil)
+			response, err := cassette.replay(r, vcrRequest{Method: http.MethodPost, URL: url, Body: testCase.RequestBody})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			b, err := io.ReadAll(response.Body)
+
+			if err != nil {
+				t.Fatalf("error reading response: %s", err)
+			}
+
+			if got, want := string(b), testCase.Expected; got != want {
+				t.Errorf("got %q, expected %q", got, want)
+			}
+		})
+	}
+
+	r := httptest.NewRequest(http.MethodPost, url, nil)
+
+	if _, err := cassette.replay(r, vcrRequest{Method: http.MethodPost, URL: url, Body: "Action=DescribeVpcs&Version=2016-11-15&VpcId.1=vpc-3"}); err == nil {
+		t.Error("expected error for request with unrecorded body")
+	}
+}
+
+func TestVCRCassetteSaveRedactsCredentials(t *testing.T) {
+	const (
+		secretAccessKey    = "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"
+		sessionToken       = "FwoGZXIvYXdzEBYaDExampleSessionTokenValue"
+		jsonSecretKey      = "je7MtGbClwBF/2Zp9Utk/h3yCo8nvbEXAMPLEKEY"
+		envSessionToken    = "IQoJb3JpZ2luX2VjEnvironmentSessionToken"
+		envSecretAccessKey = "EnvironmentSecretAccessKeyValueEXAMPLE"
+	)
+
+	t.Setenv(conns.EnvVarSessionToken, envSessionToken)
+	t.Setenv(conns.EnvVarSecretAccessKey, envSecretAccessKey)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Amz-Target") != "" {
+			w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+			io.WriteString(w, `{"roleCredentials":{"accessKeyId":"ASIAEXAMPLE","secretAccessKey":"`+jsonSecretKey+`","sessionToken":"`+envSessionToken+`"}}`) //nolint:errcheck
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+		io.WriteString(w, `<AssumeRoleResponse><AssumeRoleResult><Credentials>`+ //nolint:errcheck
+			`<AccessKeyId>ASIAEXAMPLE</AccessKeyId>`+
+			`<SecretAccessKey>`+secretAccessKey+`</SecretAccessKey>`+
+			`<SessionToken>`+sessionToken+`</SessionToken>`+
+			`<Expiration>2022-01-01T00:00:00Z</Expiration>`+
+			`</Credentials></AssumeRoleResult></AssumeRoleResponse>`)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recorder := &vcrCassette{mode: VCRModeRecord, path: path}
+	client := &http.Client{Transport: recorder.transport(http.DefaultTransport)}
+
+	r, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("Action=AssumeRole&RoleArn=arn%3Aaws%3Aiam%3A%3A123456789012%3Arole%2Ftest&RoleSessionName=test&Version=2011-06-15"))
+
+	if err != nil {
+		t.Fatalf("error creating request: %s", err)
+	}
+
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+
+	if _, err := client.Do(r); err != nil {
+		t.Fatalf("error sending request: %s", err)
+	}
+
+	// A later request echoing a secret returned earlier.
+	r, err = http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"token":"`+sessionToken+`"}`))
+
+	if err != nil {
+		t.Fatalf("error creating request: %s", err)
+	}
+
+	r.Header.Set("X-Amz-Target", "SWBPortalService.GetRoleCredentials")
+
+	if _, err := client.Do(r); err != nil {
+		t.Fatalf("error sending request: %s", err)
+	}
+
+	if err := recorder.save(); err != nil {
+		t.Fatalf("error saving cassette: %s", err)
+	}
+
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("error reading cassette: %s", err)
+	}
+
+	cassette := string(b)
+
+	for _, secret := range []string{secretAccessKey, sessionToken, jsonSecretKey, envSessionToken, envSecretAccessKey} {
+		if strings.Contains(cassette, secret) {
+			t.Errorf("expected cassette not to contain %q, got:\n%s", secret, cassette)
+		}
+	}
+
+	replayer := &vcrCassette{path: path}
+
+	if err := replayer.load(); err != nil {
+		t.Fatalf("error loading cassette: %s", err)
+	}
+
+	if got, want := len(replayer.interactions), 2; got != want {
+		t.Fatalf("got %d interactions, expected %d", got, want)
+	}
+
+	body := replayer.interactions[0].Response.Body
+
+	for _, s := range []string{"<SecretAccessKey>" + VCRRedacted + "</SecretAccessKey>", "<SessionToken>" + VCRRedacted + "</SessionToken>", "<AccessKeyId>ASIAEXAMPLE</AccessKeyId>"} {
+		if !strings.Contains(body, s) {
+			t.Errorf("expected response body to contain %q, got:\n%s", s, body)
+		}
+	}
+}
+
+type vcrTestProviderServer struct {
+	tfprotov5.ProviderServer
+
+	wrapper conns.HTTPTransportWrapper
+}
+
+func (s *vcrTestProviderServer) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	s.wrapper = conns.HTTPTransportWrapperFromContext(ctx)
+
+	return &tfprotov5.ConfigureProviderResponse{}, nil
+}
+
+func TestVCRProviderServerConfigureProvider(t *testing.T) {
+	cassette := &vcrCassette{mode: VCRModeReplay}
+	cassette.record(&vcrInteraction{
+		Request: vcrRequest{
+			Method:    http.MethodPost,
+			URL:       "https://example.com/",
+			Operation: "GetCallerIdentity",
+			Body:      "Action=GetCallerIdentity&Version=2011-06-15",
+		},
+		Response: vcrResponse{StatusCode: http.StatusOK, Body: "replayed"},
+	})
+
+	inner := &vcrTestProviderServer{}
+	server := &vcrProviderServer{ProviderServer: inner, cassette: cassette}
+
+	if _, err := server.ConfigureProvider(context.Background(), &tfprotov5.ConfigureProviderRequest{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if inner.wrapper == nil {
+		t.Fatal("expected HTTP transport wrapper in context")
+	}
+
+	client := &http.Client{Transport: inner.wrapper(http.DefaultTransport)}
+
+	if got, want := vcrTestRoundTrip(t, client, "https://example.com/"), "replayed"; got != want {
+		t.Errorf("got %q, expected %q", got, want)
+	}
+}
+
+func vcrTestRoundTrip(t *testing.T, client *http.Client, url string) string {
+	t.Helper()
+
+	r, err := http.NewRequest(http.MethodPost, url, strings.NewReader("Action=GetCallerIdentity&Version=2011-06-15"))
+
+	if err != nil {
+		t.Fatalf("error creating request: %s", err)
+	}
+
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+
+	response, err := client.Do(r)
+
+	if err != nil {
+		t.Fatalf("error sending request: %s", err)
+	}
+
+	defer response.Body.Close()
+
+	b, err := io.ReadAll(response.Body)
+
+	if err != nil {
+		t.Fatalf("error reading response: %s", err)
+	}
+
+	return string(b)
+}
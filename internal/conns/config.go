@@ -57,6 +57,7 @@ type Config struct {
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
 	HTTPProxy                      string
+	HTTPTransportWrapper           HTTPTransportWrapper
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
@@ -107,6 +108,11 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		awsbaseConfig.AssumeRole = c.AssumeRole
 	}
 
+	// Credentials are otherwise validated before the HTTP transport is wrapped.
+	if c.HTTPTransportWrapper != nil {
+		awsbaseConfig.SkipCredsValidation = true
+	}
+
 	if c.CustomCABundle != "" {
 		awsbaseConfig.CustomCABundle = c.CustomCABundle
 	}
@@ -133,6 +139,10 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		return nil, diag.Errorf("error configuring Terraform AWS Provider: %s", err)
 	}
 
+	if c.HTTPTransportWrapper != nil {
+		cfg.HTTPClient = wrapHTTPClientV2(cfg.HTTPClient, c.HTTPTransportWrapper)
+	}
+
 	if !c.SkipRegionValidation {
 		if err := awsbase.ValidateRegion(cfg.Region); err != nil {
 			return nil, diag.FromErr(err)
@@ -145,6 +155,10 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		return nil, diag.Errorf("error creating AWS SDK v1 session: %s", err)
 	}
 
+	if c.HTTPTransportWrapper != nil {
+		wrapHTTPClientV1(sess, c.HTTPTransportWrapper)
+	}
+
 	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("error retrieving account details: %s", err)
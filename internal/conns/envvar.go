@@ -27,6 +27,10 @@ const (
 	// Default static credential value for tests (AWS Go SDK does not provide this as constant)
 	// See also AWS_ACCESS_KEY_ID and AWS_PROFILE
 	EnvVarSecretAccessKey = "AWS_SECRET_ACCESS_KEY"
+
+	// Default temporary credential session token for tests (AWS Go SDK does not provide this as constant)
+	// See also AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY
+	EnvVarSessionToken = "AWS_SESSION_TOKEN"
 )
 
 // Custom environment variables used in the Terraform AWS Provider testing.
@@ -54,6 +58,19 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	EnvVarAccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For tests run against a local AWS API emulator (e.g. LocalStack or moto), the emulator endpoint URL
+	// All service endpoints are overridden and credential and region validation is skipped
+	EnvVarAccEmulator = "TF_ACC_EMULATOR"
+
+	// For tests run against a local AWS API emulator, a comma-separated list of capabilities the emulator supports
+	EnvVarAccEmulatorCapabilities = "TF_ACC_EMULATOR_CAPABILITIES"
+
+	// For tests using recorded HTTP interactions, the mode: RECORD or REPLAY
+	EnvVarAccVCRMode = "TF_ACC_VCR_MODE"
+
+	// For tests using recorded HTTP interactions, the directory containing per-test cassette files
+	EnvVarAccVCRPath = "TF_ACC_VCR_PATH"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
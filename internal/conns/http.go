@@ -0,0 +1,69 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// HTTPTransportWrapper wraps the HTTP transport used by every AWS SDK client.
+// It is used by the acceptance testing framework to record and replay API interactions.
+type HTTPTransportWrapper func(http.RoundTripper) http.RoundTripper
+
+type httpTransportWrapperKey struct{}
+
+// ContextWithHTTPTransportWrapper returns a copy of ctx carrying the specified HTTP transport wrapper.
+func ContextWithHTTPTransportWrapper(ctx context.Context, wrapper HTTPTransportWrapper) context.Context {
+	return context.WithValue(ctx, httpTransportWrapperKey{}, wrapper)
+}
+
+// HTTPTransportWrapperFromContext returns the HTTP transport wrapper carried by ctx, if any.
+func HTTPTransportWrapperFromContext(ctx context.Context) HTTPTransportWrapper {
+	if v, ok := ctx.Value(httpTransportWrapperKey{}).(HTTPTransportWrapper); ok {
+		return v
+	}
+
+	return nil
+}
+
+// roundTripperFunc adapts a function to the http.RoundTripper interface.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// wrapHTTPClientV1 wraps the transport of the HTTP client shared by all AWS SDK for Go v1 service clients created from the session.
+func wrapHTTPClientV1(sess *session.Session, wrapper HTTPTransportWrapper) {
+	httpClient := http.DefaultClient
+
+	if v := sess.Config.HTTPClient; v != nil {
+		httpClient = v
+	}
+
+	transport := httpClient.Transport
+
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	sess.Config.HTTPClient = &http.Client{
+		CheckRedirect: httpClient.CheckRedirect,
+		Jar:           httpClient.Jar,
+		Timeout:       httpClient.Timeout,
+		Transport:     wrapper(transport),
+	}
+}
+
+// wrapHTTPClientV2 wraps an AWS SDK for Go v2 HTTP client.
+func wrapHTTPClientV2(httpClient awsv2.HTTPClient, wrapper HTTPTransportWrapper) awsv2.HTTPClient {
+	return &http.Client{
+		// The wrapped client is responsible for following (or not) any redirects.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+		Transport: wrapper(roundTripperFunc(httpClient.Do)),
+	}
+}
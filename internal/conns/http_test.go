@@ -0,0 +1,77 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+func TestHTTPTransportWrapperFromContext(t *testing.T) {
+	if HTTPTransportWrapperFromContext(context.Background()) != nil {
+		t.Error("expected no wrapper")
+	}
+
+	ctx := ContextWithHTTPTransportWrapper(context.Background(), func(next http.RoundTripper) http.RoundTripper { return next })
+
+	if HTTPTransportWrapperFromContext(ctx) == nil {
+		t.Error("expected wrapper")
+	}
+}
+
+func TestWrapHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	var calls int
+	wrapper := func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			calls++
+			return next.RoundTrip(r)
+		})
+	}
+
+	sess, err := session.NewSession(&aws.Config{HTTPClient: &http.Client{}})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	wrapHTTPClientV1(sess, wrapper)
+
+	clients := map[string]interface {
+		Do(*http.Request) (*http.Response, error)
+	}{
+		"v1": sess.Copy().Config.HTTPClient,
+		"v2": wrapHTTPClientV2(&http.Client{}, wrapper),
+	}
+
+	for name, client := range clients {
+		r, err := http.NewRequest(http.MethodGet, server.URL, nil)
+
+		if err != nil {
+			t.Fatalf("%s: error creating request: %s", name, err)
+		}
+
+		response, err := client.Do(r)
+
+		if err != nil {
+			t.Fatalf("%s: error sending request: %s", name, err)
+		}
+
+		response.Body.Close()
+
+		if got, want := response.StatusCode, http.StatusNoContent; got != want {
+			t.Errorf("%s: got status %d, expected %d", name, got, want)
+		}
+	}
+
+	if got, want := calls, len(clients); got != want {
+		t.Errorf("got %d wrapped calls, expected %d", got, want)
+	}
+}
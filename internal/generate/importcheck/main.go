@@ -0,0 +1,566 @@
+// importcheck reports, for every resource registered with the provider, whether its acceptance tests
+// include an import step and whether each attribute ignored during import verification is justified.
+//
+// An ignored attribute is justified if it is Sensitive in the resource schema, if it is mentioned in the
+// Import section of the resource documentation (typically as an argument that cannot be read back from the API),
+// or if it is explained by a comment next to it in the test. Marker comments such as "// TODO" are not explanations.
+//
+// Unlike the code generators, this tool is built without the generate tag as it loads the provider's schemas.
+//
+// Usage (from the repository root):
+//
+//	go run ./internal/generate/importcheck [-o report.json]
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+const (
+	providerFile = "internal/provider/provider.go"
+	servicesDir  = "internal/service"
+	docsDir      = "website/docs/r"
+
+	servicePackagePrefix = "github.com/hashicorp/terraform-provider-aws/internal/service/"
+)
+
+const (
+	IgnoreReasonSensitive  = "sensitive"
+	IgnoreReasonDocumented = "documented"
+	IgnoreReasonCommented  = "commented"
+)
+
+// markerCommentRegexp matches comments that mark code rather than explain it, e.g. "// TODO".
+var markerCommentRegexp = regexp.MustCompile(`^(?i:todo|fixme|xxx|hack|nolint|lintignore)\b`)
+
+type Report struct {
+	Summary   Summary          `json:"summary"`
+	Resources []ResourceReport `json:"resources"`
+}
+
+type Summary struct {
+	Resources                 int `json:"resources"`
+	ResourcesWithImporter     int `json:"resources_with_importer"`
+	ResourcesWithImportStep   int `json:"resources_with_import_step"`
+	ResourcesWithImportVerify int `json:"resources_with_import_state_verify"`
+	UnjustifiedIgnores        int `json:"unjustified_ignores"`
+}
+
+type ResourceReport struct {
+	Name              string             `json:"name"`
+	Service           string             `json:"service"`
+	Importer          bool               `json:"importer"`
+	ImportStep        bool               `json:"import_step"`
+	ImportStateVerify bool               `json:"import_state_verify"`
+	IgnoredAttributes []IgnoredAttribute `json:"ignored_attributes,omitempty"`
+}
+
+type IgnoredAttribute struct {
+	Name      string `json:"name"`
+	Reason    string `json:"reason,omitempty"`
+	Justified bool   `json:"justified"`
+	Position  string `json:"position"`
+}
+
+// importStep is a TestStep with ImportState set.
+type importStep struct {
+	verify  bool
+	ignores []ignoredAttribute
+}
+
+type ignoredAttribute struct {
+	name      string
+	commented bool
+	position  token.Position
+}
+
+func main() {
+	root := flag.String("root", ".", "path to the repository root")
+	output := flag.String("o", "", "path to write the JSON report to (default standard output)")
+	flag.Parse()
+
+	services, err := resourceServices(filepath.Join(*root, providerFile))
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	steps, err := importSteps(filepath.Join(*root, servicesDir))
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	report := Report{}
+	resources := provider.Provider().ResourcesMap
+
+	for _, name := range sortedKeys(resources) {
+		r := resources[name]
+		rr := ResourceReport{
+			Name:     name,
+			Service:  services[name],
+			Importer: r.Importer != nil,
+		}
+
+		var importDocs string
+
+		if rr.Importer {
+			importDocs = importSection(filepath.Join(*root, docsDir, strings.TrimPrefix(name, "aws_")+".html.markdown"))
+		}
+
+		seen := make(map[string]bool)
+
+		for _, step := range steps[name] {
+			rr.ImportStep = true
+			rr.ImportStateVerify = rr.ImportStateVerify || step.verify
+
+			if !rr.Importer || !step.verify {
+				continue
+			}
+
+			for _, v := range step.ignores {
+				if seen[v.name] {
+					continue
+				}
+				seen[v.name] = true
+
+				ia := IgnoredAttribute{
+					Name:     v.name,
+					Position: v.position.String(),
+				}
+
+				ia.Reason = ignoreReason(r.Schema, importDocs, v)
+				ia.Justified = ia.Reason != ""
+				rr.IgnoredAttributes = append(rr.IgnoredAttributes, ia)
+
+				if !ia.Justified {
+					report.Summary.UnjustifiedIgnores++
+				}
+			}
+		}
+
+		report.Summary.Resources++
+		if rr.Importer {
+			report.Summary.ResourcesWithImporter++
+		}
+		if rr.ImportStep {
+			report.Summary.ResourcesWithImportStep++
+		}
+		if rr.ImportStateVerify {
+			report.Summary.ResourcesWithImportVerify++
+		}
+
+		report.Resources = append(report.Resources, rr)
+	}
+
+	var w io.Writer = os.Stdout
+
+	if *output != "" {
+		f, err := os.Create(*output)
+
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer f.Close()
+
+		w = f
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(report); err != nil {
+		log.Fatal(err)
+	}
+
+	s := report.Summary
+	fmt.Fprintf(os.Stderr, "%d resources: %d with importer, %d with import step, %d with import state verify, %d unjustified ignored attributes\n",
+		s.Resources, s.ResourcesWithImporter, s.ResourcesWithImportStep, s.ResourcesWithImportVerify, s.UnjustifiedIgnores)
+}
+
+// resourceServices returns the service package of each resource registered in the provider's ResourcesMap.
+func resourceServices(filename string) (map[string]string, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, nil, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Map local package names to service package names.
+	packages := make(map[string]string)
+
+	for _, v := range f.Imports {
+		path, err := strconv.Unquote(v.Path.Value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !strings.HasPrefix(path, servicePackagePrefix) {
+			continue
+		}
+
+		service := strings.TrimPrefix(path, servicePackagePrefix)
+		name := service
+
+		if v.Name != nil {
+			name = v.Name.Name
+		}
+
+		packages[name] = service
+	}
+
+	services := make(map[string]string)
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		kv, ok := n.(*ast.KeyValueExpr)
+
+		if !ok {
+			return true
+		}
+
+		if key, ok := kv.Key.(*ast.Ident); !ok || key.Name != "ResourcesMap" {
+			return true
+		}
+
+		cl, ok := kv.Value.(*ast.CompositeLit)
+
+		if !ok {
+			return false
+		}
+
+		for _, elt := range cl.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+
+			if !ok {
+				continue
+			}
+
+			name, ok := stringLiteral(kv.Key)
+
+			if !ok {
+				continue
+			}
+
+			if call, ok := kv.Value.(*ast.CallExpr); ok {
+				if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+					if pkg, ok := sel.X.(*ast.Ident); ok {
+						services[name] = packages[pkg.Name]
+					}
+				}
+			}
+		}
+
+		return false
+	})
+
+	return services, nil
+}
+
+// importSteps returns the import test steps found in all service packages' test files, keyed by resource type.
+func importSteps(dir string) (map[string][]importStep, error) {
+	steps := make(map[string][]importStep)
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() || !strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		fileSteps, err := parseImportSteps(path, nil)
+
+		if err != nil {
+			return err
+		}
+
+		for k, v := range fileSteps {
+			steps[k] = append(steps[k], v...)
+		}
+
+		return nil
+	})
+
+	return steps, err
+}
+
+// parseImportSteps returns the import test steps in a single test file, keyed by resource type.
+// If src is nil, the file is read from filename.
+func parseImportSteps(filename string, src interface{}) (map[string][]importStep, error) {
+	steps := make(map[string][]importStep)
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+
+	if err != nil {
+		return nil, err
+	}
+
+	commentLines := make(map[int]bool)
+
+	for _, cg := range f.Comments {
+		for _, c := range cg.List {
+			if statesReason(c.Text) {
+				commentLines[fset.Position(c.Pos()).Line] = true
+			}
+		}
+	}
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		cl, ok := n.(*ast.CompositeLit)
+
+		if !ok {
+			return true
+		}
+
+		fields := keyedFields(cl)
+
+		if v, ok := fields["ImportState"]; !ok || !isTrue(v) {
+			return true
+		}
+
+		v, ok := fields["ResourceName"]
+
+		if !ok {
+			return true
+		}
+
+		address, ok := resolveString(v)
+
+		if !ok {
+			return true
+		}
+
+		resourceType := strings.SplitN(strings.TrimPrefix(address, "data."), ".", 2)[0]
+		step := importStep{}
+
+		if v, ok := fields["ImportStateVerify"]; ok {
+			step.verify = isTrue(v)
+		}
+
+		if v, ok := fields["ImportStateVerifyIgnore"].(*ast.CompositeLit); ok {
+			eltLines := make(map[int]bool)
+
+			for _, elt := range v.Elts {
+				eltLines[fset.Position(elt.Pos()).Line] = true
+			}
+
+			for _, elt := range v.Elts {
+				name, ok := stringLiteral(elt)
+
+				if !ok {
+					continue
+				}
+
+				position := fset.Position(elt.Pos())
+
+				// A comment on the preceding line explains this element unless it trails another element.
+				step.ignores = append(step.ignores, ignoredAttribute{
+					name:      name,
+					commented: commentLines[position.Line] || (commentLines[position.Line-1] && !eltLines[position.Line-1]),
+					position:  position,
+				})
+			}
+		}
+
+		steps[resourceType] = append(steps[resourceType], step)
+
+		return false
+	})
+
+	return steps, nil
+}
+
+// statesReason returns whether a comment could explain why an attribute is ignored.
+// Markers such as "// TODO" and single-word comments do not.
+func statesReason(comment string) bool {
+	text := strings.TrimPrefix(comment, "//")
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+	text = strings.TrimSpace(text)
+
+	return len(strings.Fields(text)) >= 2 && !markerCommentRegexp.MatchString(text)
+}
+
+// importSection returns the contents of the Import section of the specified resource documentation file.
+func importSection(filename string) string {
+	f, err := os.Open(filename)
+
+	if err != nil {
+		return ""
+	}
+
+	defer f.Close()
+
+	return parseImportSection(f)
+}
+
+// parseImportSection returns the contents of the Import section of a resource documentation page.
+func parseImportSection(r io.Reader) string {
+	var sb strings.Builder
+	inSection := false
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if strings.HasPrefix(line, "## ") {
+			inSection = strings.TrimSpace(strings.TrimPrefix(line, "## ")) == "Import"
+			continue
+		}
+
+		if inSection {
+			sb.WriteString(line)
+			sb.WriteString("\n")
+		}
+	}
+
+	return sb.String()
+}
+
+// ignoreReason returns why an attribute ignored during import verification is justified, or "" if it is not.
+// importDocs is the Import section of the resource's documentation.
+func ignoreReason(m map[string]*schema.Schema, importDocs string, v ignoredAttribute) string {
+	switch {
+	case isSensitive(m, v.name):
+		return IgnoreReasonSensitive
+	case strings.Contains(importDocs, "`"+topLevelAttribute(v.name)+"`"):
+		return IgnoreReasonDocumented
+	case v.commented:
+		return IgnoreReasonCommented
+	}
+
+	return ""
+}
+
+// isSensitive returns whether the attribute at the specified flatmap address, or any of its parents, is Sensitive.
+func isSensitive(m map[string]*schema.Schema, address string) bool {
+	for _, part := range strings.Split(address, ".") {
+		if _, err := strconv.Atoi(part); err == nil || part == "%" || part == "#" {
+			continue
+		}
+
+		s, ok := m[part]
+
+		if !ok {
+			return false
+		}
+
+		if s.Sensitive {
+			return true
+		}
+
+		r, ok := s.Elem.(*schema.Resource)
+
+		if !ok {
+			return false
+		}
+
+		m = r.Schema
+	}
+
+	return false
+}
+
+func topLevelAttribute(address string) string {
+	return strings.SplitN(address, ".", 2)[0]
+}
+
+func keyedFields(cl *ast.CompositeLit) map[string]ast.Expr {
+	fields := make(map[string]ast.Expr)
+
+	for _, elt := range cl.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+
+		if !ok {
+			continue
+		}
+
+		if key, ok := kv.Key.(*ast.Ident); ok {
+			fields[key.Name] = kv.Value
+		}
+	}
+
+	return fields
+}
+
+func isTrue(e ast.Expr) bool {
+	v, ok := e.(*ast.Ident)
+
+	return ok && v.Name == "true"
+}
+
+func stringLiteral(e ast.Expr) (string, bool) {
+	v, ok := e.(*ast.BasicLit)
+
+	if !ok || v.Kind != token.STRING {
+		return "", false
+	}
+
+	s, err := strconv.Unquote(v.Value)
+
+	return s, err == nil
+}
+
+// resolveString returns the value of a string literal or of an identifier declared with a string literal,
+// e.g. `resourceName := "aws_vpc.test"`.
+func resolveString(e ast.Expr) (string, bool) {
+	if s, ok := stringLiteral(e); ok {
+		return s, true
+	}
+
+	ident, ok := e.(*ast.Ident)
+
+	if !ok || ident.Obj == nil {
+		return "", false
+	}
+
+	switch decl := ident.Obj.Decl.(type) {
+	case *ast.AssignStmt:
+		for i, lhs := range decl.Lhs {
+			if v, ok := lhs.(*ast.Ident); ok && v.Name == ident.Name && i < len(decl.Rhs) {
+				return stringLiteral(decl.Rhs[i])
+			}
+		}
+	case *ast.ValueSpec:
+		for i, name := range decl.Names {
+			if name.Name == ident.Name && i < len(decl.Values) {
+				return stringLiteral(decl.Values[i])
+			}
+		}
+	}
+
+	return "", false
+}
+
+func sortedKeys(m map[string]*schema.Resource) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,306 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const testSource = `package example_test
+
+func TestAccExampleThing_basic(t *testing.T) {
+	resourceName := "aws_example_thing.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThingConfig(),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+					// Not returned by the API.
+					"apply_immediately",
+					"force_destroy", // Only used on delete.
+					"final_snapshot_identifier",
+					// TODO
+					"master_password",
+					"skip_final_snapshot", //nolint:misspell // lintignore
+				},
+			},
+		},
+	})
+}
+
+func TestAccExampleOther_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ResourceName: "aws_example_other.test",
+				ImportState:  true,
+			},
+			{
+				ResourceName:      "aws_example_unverified.test",
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "data.aws_example_data.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+`
+
+func TestParseImportSteps(t *testing.T) {
+	steps, err := parseImportSteps("example_test.go", testSource)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		Name           string
+		ResourceType   string
+		ExpectedSteps  int
+		ExpectedVerify bool
+		// ExpectedIgnores are compared by name and whether they are commented.
+		ExpectedIgnores []ignoredAttribute
+	}{
+		{
+			Name:           "identifier resource name with ignores",
+			ResourceType:   "aws_example_thing",
+			ExpectedSteps:  1,
+			ExpectedVerify: true,
+			ExpectedIgnores: []ignoredAttribute{
+				{name: "password", commented: false},
+				{name: "apply_immediately", commented: true},
+				{name: "force_destroy", commented: true},
+				{name: "final_snapshot_identifier", commented: false},
+				{name: "master_password", commented: false},
+				{name: "skip_final_snapshot", commented: false},
+			},
+		},
+		{
+			Name:          "literal resource name without verify",
+			ResourceType:  "aws_example_other",
+			ExpectedSteps: 1,
+		},
+		{
+			Name:          "ImportState false",
+			ResourceType:  "aws_example_unverified",
+			ExpectedSteps: 0,
+		},
+		{
+			Name:           "data source address",
+			ResourceType:   "aws_example_data",
+			ExpectedSteps:  1,
+			ExpectedVerify: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := steps[testCase.ResourceType]
+
+			if len(got) != testCase.ExpectedSteps {
+				t.Fatalf("got %d import steps, expected %d", len(got), testCase.ExpectedSteps)
+			}
+
+			if len(got) == 0 {
+				return
+			}
+
+			step := got[0]
+
+			if step.verify != testCase.ExpectedVerify {
+				t.Errorf("got verify %t, expected %t", step.verify, testCase.ExpectedVerify)
+			}
+
+			if len(step.ignores) != len(testCase.ExpectedIgnores) {
+				t.Fatalf("got %d ignored attributes, expected %d", len(step.ignores), len(testCase.ExpectedIgnores))
+			}
+
+			for i, want := range testCase.ExpectedIgnores {
+				got := step.ignores[i]
+
+				if got.name != want.name || got.commented != want.commented {
+					t.Errorf("ignored attribute %d: got %s (commented %t), expected %s (commented %t)", i, got.name, got.commented, want.name, want.commented)
+				}
+
+				if got.position.Line == 0 {
+					t.Errorf("ignored attribute %d: expected position", i)
+				}
+			}
+		})
+	}
+}
+
+func TestStatesReason(t *testing.T) {
+	testCases := []struct {
+		Comment  string
+		Expected bool
+	}{
+		{Comment: "// Not returned by the API.", Expected: true},
+		{Comment: "/* Only used on delete. */", Expected: true},
+		{Comment: "// TODO", Expected: false},
+		{Comment: "// TODO: Remove once the API returns it.", Expected: false},
+		{Comment: "//nolint:misspell", Expected: false},
+		{Comment: "// Deprecated", Expected: false},
+		{Comment: "//", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Comment, func(t *testing.T) {
+			if got, want := statesReason(testCase.Comment), testCase.Expected; got != want {
+				t.Errorf("got %t, expected %t", got, want)
+			}
+		})
+	}
+}
+
+func TestParseImportSection(t *testing.T) {
+	const page = `# Resource: aws_example_thing
+
+## Argument Reference
+
+* ` + "`password`" + ` - (Required) Password.
+
+## Import
+
+Things can be imported using the ` + "`id`" + `. The ` + "`apply_immediately`" + ` argument cannot be imported.
+
+## Timeouts
+
+* ` + "`create`" + ` - (Default ` + "`10m`" + `)
+`
+
+	got := parseImportSection(strings.NewReader(page))
+
+	if !strings.Contains(got, "`apply_immediately`") {
+		t.Errorf("expected Import section to contain apply_immediately, got:\n%s", got)
+	}
+
+	for _, s := range []string{"`password`", "`create`", "## "} {
+		if strings.Contains(got, s) {
+			t.Errorf("expected Import section not to contain %q, got:\n%s", s, got)
+		}
+	}
+}
+
+func TestIgnoreReason(t *testing.T) {
+	m := map[string]*schema.Schema{
+		"apply_immediately": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"config": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"secret": {
+						Type:      schema.TypeString,
+						Optional:  true,
+						Sensitive: true,
+					},
+					"value": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"force_destroy": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"password": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+	}
+
+	const importDocs = "The `apply_immediately` argument and `config` block cannot be imported.\n"
+
+	testCases := []struct {
+		Name      string
+		Attribute ignoredAttribute
+		Expected  string
+	}{
+		{
+			Name:      "sensitive",
+			Attribute: ignoredAttribute{name: "password"},
+			Expected:  IgnoreReasonSensitive,
+		},
+		{
+			Name:      "nested sensitive",
+			Attribute: ignoredAttribute{name: "config.0.secret"},
+			Expected:  IgnoreReasonSensitive,
+		},
+		{
+			Name:      "sensitive takes precedence over comment",
+			Attribute: ignoredAttribute{name: "password", commented: true},
+			Expected:  IgnoreReasonSensitive,
+		},
+		{
+			Name:      "documented",
+			Attribute: ignoredAttribute{name: "apply_immediately"},
+			Expected:  IgnoreReasonDocumented,
+		},
+		{
+			Name:      "documented parent",
+			Attribute: ignoredAttribute{name: "config.0.value"},
+			Expected:  IgnoreReasonDocumented,
+		},
+		{
+			Name:      "commented",
+			Attribute: ignoredAttribute{name: "force_destroy", commented: true},
+			Expected:  IgnoreReasonCommented,
+		},
+		{
+			Name:      "unjustified",
+			Attribute: ignoredAttribute{name: "force_destroy"},
+			Expected:  "",
+		},
+		{
+			Name:      "not in schema",
+			Attribute: ignoredAttribute{name: "removed"},
+			Expected:  "",
+		},
+		{
+			Name:      "count of non-sensitive list",
+			Attribute: ignoredAttribute{name: "force_destroy.#"},
+			Expected:  "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got, want := ignoreReason(m, importDocs, testCase.Attribute), testCase.Expected; got != want {
+				t.Errorf("got %q, expected %q", got, want)
+			}
+		})
+	}
+}
+
+func TestIgnoreReason_undocumentedSubstring(t *testing.T) {
+	m := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	// Only backquoted mentions in the Import section count.
+	if got := ignoreReason(m, "The `name_prefix` argument and the name cannot be imported.\n", ignoredAttribute{name: "name"}); got != "" {
+		t.Errorf("got %q, expected no justification", got)
+	}
+}
@@ -0,0 +1,273 @@
+// schemadocs checks that the Argument Reference and Attributes Reference sections of each resource documentation page
+// (website/docs/r/*.html.markdown) are consistent with the resource's schema.
+//
+// Only top-level arguments and attributes are checked. It reports
+//   - arguments and attributes in the schema that are not documented
+//   - documented arguments and attributes that are not in the schema
+//   - arguments documented as Required that are Optional in the schema, and vice versa
+//
+// With -fix, the sections are rewritten: labels are corrected, entries not in the schema are removed and
+// entries for undocumented arguments and attributes are added using the schema's descriptions.
+// Problems that cannot be fixed automatically are still reported.
+//
+// Like importcheck, this tool is built without the generate tag as it loads the provider's schemas.
+// It does not require network access or AWS credentials.
+//
+// Usage (from the repository root):
+//
+//	go run ./internal/generate/schemadocs [-fix] [resource-type ...]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+const docsDir = "website/docs/r"
+
+// problem is an inconsistency between a resource's schema and its documentation.
+type problem struct {
+	filename string
+	line     int
+	message  string
+	// fixable is true if the edits returned alongside the problem fix it.
+	fixable bool
+}
+
+func (p problem) String() string {
+	if p.line > 0 {
+		return fmt.Sprintf("%s:%d: %s", p.filename, p.line, p.message)
+	}
+
+	return fmt.Sprintf("%s: %s", p.filename, p.message)
+}
+
+func main() {
+	root := flag.String("root", ".", "path to the repository root")
+	fix := flag.Bool("fix", false, "rewrite documentation sections to match the schema")
+	flag.Parse()
+
+	resources := provider.Provider().ResourcesMap
+	names := flag.Args()
+
+	if len(names) == 0 {
+		for name := range resources {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	var problems []problem
+
+	for _, name := range names {
+		r, ok := resources[name]
+
+		if !ok {
+			log.Fatalf("resource type %s not found", name)
+		}
+
+		filename := filepath.Join(*root, docsDir, strings.TrimPrefix(name, "aws_")+".html.markdown")
+		b, err := ioutil.ReadFile(filename)
+
+		if os.IsNotExist(err) {
+			// Missing documentation is reported by tfproviderdocs.
+			continue
+		}
+
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		p := parsePage(string(b))
+		ps, edits := check(filename, r.Schema, p)
+
+		if *fix && len(edits) > 0 {
+			if err := ioutil.WriteFile(filename, []byte(p.apply(edits)), 0644); err != nil {
+				log.Fatal(err)
+			}
+
+			// Problems that the edits don't fix still need attention.
+			problems = append(problems, unfixable(ps)...)
+
+			continue
+		}
+
+		problems = append(problems, ps...)
+	}
+
+	for _, p := range problems {
+		fmt.Println(p)
+	}
+
+	if len(problems) > 0 {
+		fmt.Fprintf(os.Stderr, "\nFound %d inconsistencies between resource schemas and documentation.\n", len(problems))
+		fmt.Fprintln(os.Stderr, "To automatically fix them, run 'make website-schema-lint-fix' and review the changes.")
+		os.Exit(1)
+	}
+}
+
+// check returns the inconsistencies between a resource schema and its parsed documentation page,
+// along with the edits that fix them.
+func check(filename string, m map[string]*schema.Schema, p *page) ([]problem, []edit) {
+	var problems []problem
+	var edits []edit
+
+	report := func(e *entry, fixable bool, format string, a ...interface{}) {
+		pr := problem{
+			filename: filename,
+			message:  fmt.Sprintf(format, a...),
+			fixable:  fixable,
+		}
+
+		if e != nil {
+			pr.line = e.start + 1
+		}
+
+		problems = append(problems, pr)
+	}
+
+	arguments, attributes := p.sections[sectionArguments], p.sections[sectionAttributes]
+
+	if arguments == nil {
+		report(nil, false, "missing %q section", sectionArguments)
+	} else {
+		for _, e := range arguments.entries {
+			s, ok := m[e.name]
+
+			switch {
+			case !ok:
+				report(e, true, "argument %q is not in the schema", e.name)
+				edits = append(edits, edit{start: e.start, end: e.end})
+			case !s.Required && !s.Optional:
+				report(e, false, "%q is documented as an argument but is computed-only", e.name)
+			case e.label == "Required" && !s.Required:
+				report(e, true, "argument %q is documented as Required but is Optional", e.name)
+				edits = append(edits, relabel(p, e, "Optional"))
+			case e.label == "Optional" && s.Required:
+				report(e, true, "argument %q is documented as Optional but is Required", e.name)
+				edits = append(edits, relabel(p, e, "Required"))
+			}
+		}
+	}
+
+	if attributes != nil {
+		for _, e := range attributes.entries {
+			if _, ok := m[e.name]; !ok && e.name != "id" {
+				report(e, true, "attribute %q is not in the schema", e.name)
+				edits = append(edits, edit{start: e.start, end: e.end})
+			}
+		}
+	}
+
+	var missingArguments, missingAttributes []string
+
+	for _, name := range sortedKeys(m) {
+		s := m[name]
+
+		if s.Deprecated != "" {
+			continue
+		}
+
+		// Optional and computed arguments such as tags_all may be documented as attributes.
+		if s.Optional && s.Computed && attributes.entry(name) != nil {
+			continue
+		}
+
+		if s.Required || s.Optional {
+			if arguments != nil && arguments.entry(name) == nil {
+				report(nil, true, "argument %q is not documented", name)
+				missingArguments = append(missingArguments, newEntry(name, s))
+			}
+
+			continue
+		}
+
+		if arguments.entry(name) == nil && attributes.entry(name) == nil {
+			report(nil, attributes != nil, "attribute %q is not documented", name)
+			missingAttributes = append(missingAttributes, newEntry(name, s))
+		}
+	}
+
+	if len(missingArguments) > 0 {
+		edits = append(edits, insert(arguments, missingArguments))
+	}
+
+	if len(missingAttributes) > 0 && attributes != nil {
+		edits = append(edits, insert(attributes, missingAttributes))
+	}
+
+	return problems, edits
+}
+
+// unfixable returns the problems that are not fixed by the edits returned by check.
+func unfixable(problems []problem) []problem {
+	var result []problem
+
+	for _, p := range problems {
+		if !p.fixable {
+			result = append(result, p)
+		}
+	}
+
+	return result
+}
+
+func relabel(p *page, e *entry, label string) edit {
+	line := p.lines[e.start]
+	i := strings.Index(line, "("+e.label)
+
+	return edit{
+		start: e.start,
+		end:   e.start + 1,
+		lines: []string{line[:i+1] + label + line[i+1+len(e.label):]},
+	}
+}
+
+func insert(l *list, lines []string) edit {
+	if len(l.entries) == 0 {
+		lines = append([]string{""}, lines...)
+	}
+
+	return edit{start: l.end, end: l.end, lines: lines}
+}
+
+func newEntry(name string, s *schema.Schema) string {
+	var label string
+
+	switch {
+	case s.Required:
+		label = " (Required)"
+	case s.Optional:
+		label = " (Optional)"
+	}
+
+	line := fmt.Sprintf("* `%s` -%s", name, label)
+
+	if description := strings.TrimSpace(s.Description); description != "" {
+		line += " " + description
+	}
+
+	return line
+}
+
+func sortedKeys(m map[string]*schema.Schema) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
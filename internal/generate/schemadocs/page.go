@@ -0,0 +1,190 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	sectionArguments  = "Argument Reference"
+	sectionAttributes = "Attributes Reference"
+)
+
+var (
+	calloutRegexp          = regexp.MustCompile(`^[~!-]> `)
+	entryRegexp            = regexp.MustCompile("^[*-] `([a-z0-9_]+)`")
+	listIntroductionRegexp = regexp.MustCompile(`^The following (arguments|attributes) .*:\s*$`)
+	labelRegexp            = regexp.MustCompile(`^[*-] ` + "`[a-z0-9_]+`" + `\s*-?\s*\((Required|Optional)\b`)
+)
+
+// page is a parsed resource documentation page.
+type page struct {
+	lines    []string
+	sections map[string]*list
+}
+
+// list is the top-level bullet list of a documentation page section.
+// Bullet lists following a paragraph or heading within the section describe nested blocks and are not included.
+type list struct {
+	entries []*entry
+	// end is the index of the line following the list's last entry.
+	end int
+}
+
+// entry is a single bullet list item, e.g. "* `name` - (Required) The name of the thing.".
+type entry struct {
+	name  string
+	label string
+	// start and end are the indices of the item's first line and of the line following its last line.
+	start, end int
+}
+
+func parsePage(content string) *page {
+	p := &page{
+		lines:    strings.Split(content, "\n"),
+		sections: make(map[string]*list),
+	}
+
+	for i := 0; i < len(p.lines); i++ {
+		line := p.lines[i]
+
+		if !strings.HasPrefix(line, "## ") {
+			continue
+		}
+
+		switch title := strings.TrimSpace(strings.TrimPrefix(line, "## ")); title {
+		case sectionArguments, sectionAttributes:
+			p.sections[title] = p.parseList(i + 1)
+		}
+	}
+
+	return p
+}
+
+func (p *page) parseList(start int) *list {
+	l := &list{end: start}
+
+	var current *entry
+	blank, callout := false, false
+
+	for i := start; i < len(p.lines); i++ {
+		line := p.lines[i]
+
+		if strings.HasPrefix(line, "#") {
+			break
+		}
+
+		if strings.TrimSpace(line) == "" {
+			blank, callout = true, false
+			continue
+		}
+
+		if m := entryRegexp.FindStringSubmatch(line); m != nil {
+			current = &entry{
+				name:  m[1],
+				start: i,
+				end:   i + 1,
+			}
+
+			if m := labelRegexp.FindStringSubmatch(line); m != nil {
+				current.label = m[1]
+			}
+
+			l.entries = append(l.entries, current)
+			l.end = i + 1
+			blank = false
+
+			continue
+		}
+
+		if current == nil {
+			// Introductory text.
+			continue
+		}
+
+		// Indented lines, and lines directly following an item, continue it.
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") || !blank {
+			current.end = i + 1
+			l.end = i + 1
+			blank = false
+
+			continue
+		}
+
+		// Introductions such as "The following arguments are optional:" separate parts of the top-level list.
+		if listIntroductionRegexp.MatchString(line) {
+			continue
+		}
+
+		// Callouts such as "~> **NOTE:** ..." annotate the top-level list and do not end it.
+		if callout || calloutRegexp.MatchString(line) {
+			callout = true
+			continue
+		}
+
+		// Any other paragraph ends the top-level list.
+		break
+	}
+
+	return l
+}
+
+func (l *list) entry(name string) *entry {
+	if l == nil {
+		return nil
+	}
+
+	for _, e := range l.entries {
+		if e.name == name {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// edit is a change to a page's lines: the lines in [start, end) are replaced with the specified lines.
+type edit struct {
+	start, end int
+	lines      []string
+}
+
+// apply returns the page's content with the specified non-overlapping edits applied.
+func (p *page) apply(edits []edit) string {
+	var lines []string
+	next := 0
+
+	for i := 0; i <= len(p.lines); i++ {
+		for _, e := range edits {
+			if e.start == i && e.end == i {
+				lines = append(lines, e.lines...)
+			}
+		}
+
+		if i == len(p.lines) {
+			break
+		}
+
+		if i < next {
+			continue
+		}
+
+		replaced := false
+
+		for _, e := range edits {
+			if e.start == i && e.end > i {
+				lines = append(lines, e.lines...)
+				next = e.end
+				replaced = true
+
+				break
+			}
+		}
+
+		if !replaced {
+			lines = append(lines, p.lines[i])
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,271 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const testPage = `---
+subcategory: "Example"
+---
+
+# Resource: aws_example_thing
+
+## Argument Reference
+
+The following arguments are supported:
+
+* ` + "`name`" + ` - (Optional) Name of the thing.
+* ` + "`description`" + ` - (Required) Description of the thing,
+which continues on the next line.
+* ` + "`removed`" + ` - (Optional) No longer supported.
+  Indented continuation.
+
+The following arguments are optional:
+
+* ` + "`config`" + ` - (Optional) Configuration block. See below.
+
+The ` + "`config`" + ` block supports:
+
+* ` + "`nested`" + ` - (Required) Nested argument.
+
+## Attributes Reference
+
+In addition to all arguments above, the following attributes are exported:
+
+* ` + "`id`" + ` - ID of the thing.
+* ` + "`tags_all`" + ` - Map of tags.
+
+## Import
+`
+
+func testSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"arn": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ARN of the thing.",
+		},
+		"config": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"nested": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"tags_all": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+		"timeout": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+	}
+}
+
+func TestParsePage(t *testing.T) {
+	p := parsePage(testPage)
+
+	arguments := p.sections[sectionArguments]
+
+	if arguments == nil {
+		t.Fatalf("expected %q section", sectionArguments)
+	}
+
+	var names []string
+
+	for _, e := range arguments.entries {
+		names = append(names, e.name+":"+e.label)
+	}
+
+	if got, want := strings.Join(names, ","), "name:Optional,description:Required,removed:Optional,config:Optional"; got != want {
+		t.Errorf("got arguments %q, expected %q", got, want)
+	}
+
+	if e := arguments.entry("description"); e == nil || e.end-e.start != 2 {
+		t.Errorf("expected description entry to span 2 lines, got %+v", e)
+	}
+
+	if e := arguments.entry("removed"); e == nil || e.end-e.start != 2 {
+		t.Errorf("expected removed entry to span 2 lines, got %+v", e)
+	}
+
+	if got, want := len(p.sections[sectionAttributes].entries), 2; got != want {
+		t.Errorf("got %d attributes, expected %d", got, want)
+	}
+}
+
+func TestParsePage_callout(t *testing.T) {
+	const page = `# Resource: aws_example_thing
+
+## Argument Reference
+
+The following arguments are supported:
+
+* ` + "`name`" + ` - (Required) Name of the thing.
+
+~> **NOTE:** This note applies to the arguments above
+and continues on the next line.
+
+* ` + "`description`" + ` - (Optional) Description of the thing.
+
+-> Callouts of every kind are part of the list.
+
+!> **WARNING:** Including warnings.
+
+* ` + "`timeout`" + ` - (Optional) Timeout.
+
+The ` + "`config`" + ` block supports:
+
+* ` + "`nested`" + ` - (Required) Nested argument.
+`
+
+	p := parsePage(page)
+	arguments := p.sections[sectionArguments]
+
+	if arguments == nil {
+		t.Fatalf("expected %q section", sectionArguments)
+	}
+
+	var names []string
+
+	for _, e := range arguments.entries {
+		names = append(names, e.name)
+	}
+
+	if got, want := strings.Join(names, ","), "name,description,timeout"; got != want {
+		t.Errorf("got arguments %q, expected %q", got, want)
+	}
+
+	if e := arguments.entry("name"); e == nil || e.end-e.start != 1 {
+		t.Errorf("expected name entry to span 1 line, got %+v", e)
+	}
+
+	m := testSchema()
+	delete(m, "arn")
+	delete(m, "config")
+	delete(m, "tags_all")
+
+	if problems, edits := check("thing.html.markdown", m, p); len(problems) != 0 || len(edits) != 0 {
+		t.Errorf("expected no problems or edits, got %v", problems)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	p := parsePage(testPage)
+	problems, edits := check("thing.html.markdown", testSchema(), p)
+
+	var messages []string
+
+	for _, p := range problems {
+		messages = append(messages, p.String())
+	}
+
+	expected := []string{
+		`thing.html.markdown:11: argument "name" is documented as Optional but is Required`,
+		`thing.html.markdown:12: argument "description" is documented as Required but is Optional`,
+		`thing.html.markdown:14: argument "removed" is not in the schema`,
+		`thing.html.markdown: attribute "arn" is not documented`,
+		`thing.html.markdown: argument "timeout" is not documented`,
+	}
+
+	if got, want := strings.Join(messages, "\n"), strings.Join(expected, "\n"); got != want {
+		t.Errorf("got problems:\n%s\n\nexpected:\n%s", got, want)
+	}
+
+	content := p.apply(edits)
+
+	for _, s := range []string{
+		"* `name` - (Required) Name of the thing.",
+		"* `description` - (Optional) Description of the thing,\nwhich continues on the next line.\n\nThe following arguments are optional:\n\n* `config`",
+		"Configuration block. See below.\n* `timeout` - (Optional)\n\nThe `config` block supports:",
+		"* `tags_all` - Map of tags.\n* `arn` - ARN of the thing.\n",
+	} {
+		if !strings.Contains(content, s) {
+			t.Errorf("expected rewritten page to contain %q, got:\n%s", s, content)
+		}
+	}
+
+	if strings.Contains(content, "removed") {
+		t.Errorf("expected rewritten page not to contain removed argument, got:\n%s", content)
+	}
+
+	if got := unfixable(problems); len(got) != 0 {
+		t.Errorf("expected all problems to be fixable, got %v", got)
+	}
+
+	if problems, _ := check("thing.html.markdown", testSchema(), parsePage(content)); len(problems) != 0 {
+		t.Errorf("expected no problems after rewrite, got %v", problems)
+	}
+}
+
+func TestCheckUnfixable(t *testing.T) {
+	const content = `# Resource: aws_example_thing
+
+## Argument Reference
+
+* ` + "`name`" + ` - (Optional) Name of the thing.
+* ` + "`arn`" + ` - ARN of the thing.
+
+## Import
+`
+
+	p := parsePage(content)
+	problems, edits := check("thing.html.markdown", testSchema(), p)
+
+	var messages []string
+
+	for _, p := range unfixable(problems) {
+		messages = append(messages, p.String())
+	}
+
+	expected := []string{
+		`thing.html.markdown:6: "arn" is documented as an argument but is computed-only`,
+	}
+
+	if got, want := strings.Join(messages, "\n"), strings.Join(expected, "\n"); got != want {
+		t.Errorf("got unfixable problems:\n%s\n\nexpected:\n%s", got, want)
+	}
+
+	// The fixable problems are fixed, the unfixable ones remain.
+	problems, _ = check("thing.html.markdown", testSchema(), parsePage(p.apply(edits)))
+	messages = nil
+
+	for _, p := range problems {
+		messages = append(messages, p.String())
+	}
+
+	if got, want := strings.Join(messages, "\n"), strings.Join(expected, "\n"); got != want {
+		t.Errorf("got problems after rewrite:\n%s\n\nexpected:\n%s", got, want)
+	}
+}
+
+func TestCheckMissingSections(t *testing.T) {
+	p := parsePage("# Resource: aws_example_thing\n")
+	problems, _ := check("thing.html.markdown", testSchema(), p)
+
+	if got, want := len(unfixable(problems)), len(problems); got != want {
+		t.Errorf("got %d unfixable problems, expected %d (all of %v)", got, want, problems)
+	}
+
+	if len(problems) == 0 {
+		t.Error("expected problems, got none")
+	}
+}
@@ -530,77 +530,85 @@ func Provider() *schema.Provider {
 
 			"aws_dynamodb_table": dynamodb.DataSourceTable(),
 
-			"aws_ami":                                        ec2.DataSourceAMI(),
-			"aws_ami_ids":                                    ec2.DataSourceAMIIDs(),
-			"aws_availability_zone":                          ec2.DataSourceAvailabilityZone(),
-			"aws_availability_zones":                         ec2.DataSourceAvailabilityZones(),
-			"aws_customer_gateway":                           ec2.DataSourceCustomerGateway(),
-			"aws_ebs_default_kms_key":                        ec2.DataSourceEBSDefaultKMSKey(),
-			"aws_ebs_encryption_by_default":                  ec2.DataSourceEBSEncryptionByDefault(),
-			"aws_ebs_snapshot":                               ec2.DataSourceEBSSnapshot(),
-			"aws_ebs_snapshot_ids":                           ec2.DataSourceEBSSnapshotIDs(),
-			"aws_ebs_volume":                                 ec2.DataSourceEBSVolume(),
-			"aws_ebs_volumes":                                ec2.DataSourceEBSVolumes(),
-			"aws_ec2_client_vpn_endpoint":                    ec2.DataSourceClientVPNEndpoint(),
-			"aws_ec2_coip_pool":                              ec2.DataSourceCoIPPool(),
-			"aws_ec2_coip_pools":                             ec2.DataSourceCoIPPools(),
-			"aws_ec2_host":                                   ec2.DataSourceHost(),
-			"aws_ec2_instance_type_offering":                 ec2.DataSourceInstanceTypeOffering(),
-			"aws_ec2_instance_type_offerings":                ec2.DataSourceInstanceTypeOfferings(),
-			"aws_ec2_instance_type":                          ec2.DataSourceInstanceType(),
-			"aws_ec2_instance_types":                         ec2.DataSourceInstanceTypes(),
-			"aws_ec2_local_gateway_route_table":              ec2.DataSourceLocalGatewayRouteTable(),
-			"aws_ec2_local_gateway_route_tables":             ec2.DataSourceLocalGatewayRouteTables(),
-			"aws_ec2_local_gateway_virtual_interface":        ec2.DataSourceLocalGatewayVirtualInterface(),
-			"aws_ec2_local_gateway_virtual_interface_group":  ec2.DataSourceLocalGatewayVirtualInterfaceGroup(),
-			"aws_ec2_local_gateway_virtual_interface_groups": ec2.DataSourceLocalGatewayVirtualInterfaceGroups(),
-			"aws_ec2_local_gateway":                          ec2.DataSourceLocalGateway(),
-			"aws_ec2_local_gateways":                         ec2.DataSourceLocalGateways(),
-			"aws_ec2_managed_prefix_list":                    ec2.DataSourceManagedPrefixList(),
-			"aws_ec2_serial_console_access":                  ec2.DataSourceSerialConsoleAccess(),
-			"aws_ec2_spot_price":                             ec2.DataSourceSpotPrice(),
-			"aws_ec2_transit_gateway":                        ec2.DataSourceTransitGateway(),
-			"aws_ec2_transit_gateway_connect":                ec2.DataSourceTransitGatewayConnect(),
-			"aws_ec2_transit_gateway_connect_peer":           ec2.DataSourceTransitGatewayConnectPeer(),
-			"aws_ec2_transit_gateway_dx_gateway_attachment":  ec2.DataSourceTransitGatewayDxGatewayAttachment(),
-			"aws_ec2_transit_gateway_multicast_domain":       ec2.DataSourceTransitGatewayMulticastDomain(),
-			"aws_ec2_transit_gateway_peering_attachment":     ec2.DataSourceTransitGatewayPeeringAttachment(),
-			"aws_ec2_transit_gateway_route_table":            ec2.DataSourceTransitGatewayRouteTable(),
-			"aws_ec2_transit_gateway_route_tables":           ec2.DataSourceTransitGatewayRouteTables(),
-			"aws_ec2_transit_gateway_vpc_attachment":         ec2.DataSourceTransitGatewayVPCAttachment(),
-			"aws_ec2_transit_gateway_vpc_attachments":        ec2.DataSourceTransitGatewayVPCAttachments(),
-			"aws_ec2_transit_gateway_vpn_attachment":         ec2.DataSourceTransitGatewayVPNAttachment(),
-			"aws_eip":                                        ec2.DataSourceEIP(),
-			"aws_eips":                                       ec2.DataSourceEIPs(),
-			"aws_instance":                                   ec2.DataSourceInstance(),
-			"aws_instances":                                  ec2.DataSourceInstances(),
-			"aws_internet_gateway":                           ec2.DataSourceInternetGateway(),
-			"aws_key_pair":                                   ec2.DataSourceKeyPair(),
-			"aws_launch_template":                            ec2.DataSourceLaunchTemplate(),
-			"aws_nat_gateway":                                ec2.DataSourceNATGateway(),
-			"aws_nat_gateways":                               ec2.DataSourceNATGateways(),
-			"aws_network_acls":                               ec2.DataSourceNetworkACLs(),
-			"aws_network_interface":                          ec2.DataSourceNetworkInterface(),
-			"aws_network_interfaces":                         ec2.DataSourceNetworkInterfaces(),
-			"aws_prefix_list":                                ec2.DataSourcePrefixList(),
-			"aws_route_table":                                ec2.DataSourceRouteTable(),
-			"aws_route_tables":                               ec2.DataSourceRouteTables(),
-			"aws_route":                                      ec2.DataSourceRoute(),
-			"aws_security_group":                             ec2.DataSourceSecurityGroup(),
-			"aws_security_groups":                            ec2.DataSourceSecurityGroups(),
-			"aws_subnet_ids":                                 ec2.DataSourceSubnetIDs(),
-			"aws_subnet":                                     ec2.DataSourceSubnet(),
-			"aws_subnets":                                    ec2.DataSourceSubnets(),
-			"aws_vpc_dhcp_options":                           ec2.DataSourceVPCDHCPOptions(),
-			"aws_vpc_endpoint_service":                       ec2.DataSourceVPCEndpointService(),
-			"aws_vpc_endpoint":                               ec2.DataSourceVPCEndpoint(),
-			"aws_vpc_ipam_pool":                              ec2.DataSourceIPAMPool(),
-			"aws_vpc_ipam_preview_next_cidr":                 ec2.DataSourceIPAMPreviewNextCIDR(),
-			"aws_vpc_peering_connection":                     ec2.DataSourceVPCPeeringConnection(),
-			"aws_vpc_peering_connections":                    ec2.DataSourceVPCPeeringConnections(),
-			"aws_vpc":                                        ec2.DataSourceVPC(),
-			"aws_vpcs":                                       ec2.DataSourceVPCs(),
-			"aws_vpn_gateway":                                ec2.DataSourceVPNGateway(),
+			"aws_ami":                                          ec2.DataSourceAMI(),
+			"aws_ami_ids":                                      ec2.DataSourceAMIIDs(),
+			"aws_availability_zone":                            ec2.DataSourceAvailabilityZone(),
+			"aws_availability_zones":                           ec2.DataSourceAvailabilityZones(),
+			"aws_customer_gateway":                             ec2.DataSourceCustomerGateway(),
+			"aws_ebs_default_kms_key":                          ec2.DataSourceEBSDefaultKMSKey(),
+			"aws_ebs_encryption_by_default":                    ec2.DataSourceEBSEncryptionByDefault(),
+			"aws_ebs_snapshot":                                 ec2.DataSourceEBSSnapshot(),
+			"aws_ebs_snapshot_ids":                             ec2.DataSourceEBSSnapshotIDs(),
+			"aws_ebs_volume":                                   ec2.DataSourceEBSVolume(),
+			"aws_ebs_volumes":                                  ec2.DataSourceEBSVolumes(),
+			"aws_ec2_client_vpn_endpoint":                      ec2.DataSourceClientVPNEndpoint(),
+			"aws_ec2_coip_pool":                                ec2.DataSourceCoIPPool(),
+			"aws_ec2_coip_pools":                               ec2.DataSourceCoIPPools(),
+			"aws_ec2_host":                                     ec2.DataSourceHost(),
+			"aws_ec2_instance_type_offering":                   ec2.DataSourceInstanceTypeOffering(),
+			"aws_ec2_instance_type_offerings":                  ec2.DataSourceInstanceTypeOfferings(),
+			"aws_ec2_instance_type":                            ec2.DataSourceInstanceType(),
+			"aws_ec2_instance_types":                           ec2.DataSourceInstanceTypes(),
+			"aws_ec2_local_gateway_route_table":                ec2.DataSourceLocalGatewayRouteTable(),
+			"aws_ec2_local_gateway_route_tables":               ec2.DataSourceLocalGatewayRouteTables(),
+			"aws_ec2_local_gateway_virtual_interface":          ec2.DataSourceLocalGatewayVirtualInterface(),
+			"aws_ec2_local_gateway_virtual_interface_group":    ec2.DataSourceLocalGatewayVirtualInterfaceGroup(),
+			"aws_ec2_local_gateway_virtual_interface_groups":   ec2.DataSourceLocalGatewayVirtualInterfaceGroups(),
+			"aws_ec2_local_gateway":                            ec2.DataSourceLocalGateway(),
+			"aws_ec2_local_gateways":                           ec2.DataSourceLocalGateways(),
+			"aws_ec2_managed_prefix_list":                      ec2.DataSourceManagedPrefixList(),
+			"aws_ec2_managed_prefix_lists":                     ec2.DataSourceManagedPrefixLists(),
+			"aws_ec2_serial_console_access":                    ec2.DataSourceSerialConsoleAccess(),
+			"aws_ec2_spot_price":                               ec2.DataSourceSpotPrice(),
+			"aws_ec2_transit_gateway":                          ec2.DataSourceTransitGateway(),
+			"aws_ec2_transit_gateway_attachments":              ec2.DataSourceTransitGatewayAttachments(),
+			"aws_ec2_transit_gateway_connect":                  ec2.DataSourceTransitGatewayConnect(),
+			"aws_ec2_transit_gateway_connect_peer":             ec2.DataSourceTransitGatewayConnectPeer(),
+			"aws_ec2_transit_gateway_dx_gateway_attachment":    ec2.DataSourceTransitGatewayDxGatewayAttachment(),
+			"aws_ec2_transit_gateway_multicast_domain":         ec2.DataSourceTransitGatewayMulticastDomain(),
+			"aws_ec2_transit_gateway_peering_attachment":       ec2.DataSourceTransitGatewayPeeringAttachment(),
+			"aws_ec2_transit_gateway_route_table":              ec2.DataSourceTransitGatewayRouteTable(),
+			"aws_ec2_transit_gateway_route_table_associations": ec2.DataSourceTransitGatewayRouteTableAssociations(),
+			"aws_ec2_transit_gateway_route_table_propagations": ec2.DataSourceTransitGatewayRouteTablePropagations(),
+			"aws_ec2_transit_gateway_route_table_routes":       ec2.DataSourceTransitGatewayRouteTableRoutes(),
+			"aws_ec2_transit_gateway_route_tables":             ec2.DataSourceTransitGatewayRouteTables(),
+			"aws_ec2_transit_gateway_vpc_attachment":           ec2.DataSourceTransitGatewayVPCAttachment(),
+			"aws_ec2_transit_gateway_vpc_attachments":          ec2.DataSourceTransitGatewayVPCAttachments(),
+			"aws_ec2_transit_gateway_vpn_attachment":           ec2.DataSourceTransitGatewayVPNAttachment(),
+			"aws_eip":                                          ec2.DataSourceEIP(),
+			"aws_eips":                                         ec2.DataSourceEIPs(),
+			"aws_instance":                                     ec2.DataSourceInstance(),
+			"aws_instances":                                    ec2.DataSourceInstances(),
+			"aws_internet_gateway":                             ec2.DataSourceInternetGateway(),
+			"aws_key_pair":                                     ec2.DataSourceKeyPair(),
+			"aws_launch_template":                              ec2.DataSourceLaunchTemplate(),
+			"aws_nat_gateway":                                  ec2.DataSourceNATGateway(),
+			"aws_nat_gateways":                                 ec2.DataSourceNATGateways(),
+			"aws_network_acls":                                 ec2.DataSourceNetworkACLs(),
+			"aws_network_interface":                            ec2.DataSourceNetworkInterface(),
+			"aws_network_interfaces":                           ec2.DataSourceNetworkInterfaces(),
+			"aws_prefix_list":                                  ec2.DataSourcePrefixList(),
+			"aws_route_table":                                  ec2.DataSourceRouteTable(),
+			"aws_route_tables":                                 ec2.DataSourceRouteTables(),
+			"aws_route":                                        ec2.DataSourceRoute(),
+			"aws_security_group":                               ec2.DataSourceSecurityGroup(),
+			"aws_security_groups":                              ec2.DataSourceSecurityGroups(),
+			"aws_subnet_ids":                                   ec2.DataSourceSubnetIDs(),
+			"aws_subnet":                                       ec2.DataSourceSubnet(),
+			"aws_subnets":                                      ec2.DataSourceSubnets(),
+			"aws_vpc_dhcp_options":                             ec2.DataSourceVPCDHCPOptions(),
+			"aws_vpc_endpoint_service":                         ec2.DataSourceVPCEndpointService(),
+			"aws_vpc_endpoint":                                 ec2.DataSourceVPCEndpoint(),
+			"aws_vpc_ipam_pool":                                ec2.DataSourceIPAMPool(),
+			"aws_vpc_ipam_pool_allocations":                    ec2.DataSourceIPAMPoolAllocations(),
+			"aws_vpc_ipam_preview_next_cidr":                   ec2.DataSourceIPAMPreviewNextCIDR(),
+			"aws_vpc_peering_connection":                       ec2.DataSourceVPCPeeringConnection(),
+			"aws_vpc_peering_connections":                      ec2.DataSourceVPCPeeringConnections(),
+			"aws_vpc_security_group_rule":                      ec2.DataSourceSecurityGroupRule(),
+			"aws_vpc_security_group_rules":                     ec2.DataSourceSecurityGroupRules(),
+			"aws_vpc":                                          ec2.DataSourceVPC(),
+			"aws_vpcs":                                         ec2.DataSourceVPCs(),
+			"aws_vpn_gateway":                                  ec2.DataSourceVPNGateway(),
 
 			"aws_ecr_authorization_token": ecr.DataSourceAuthorizationToken(),
 			"aws_ecr_image":               ecr.DataSourceImage(),
@@ -609,9 +617,13 @@ func Provider() *schema.Provider {
 			"aws_ecrpublic_authorization_token": ecrpublic.DataSourceAuthorizationToken(),
 
 			"aws_ecs_cluster":              ecs.DataSourceCluster(),
+			"aws_ecs_clusters":             ecs.DataSourceClusters(),
 			"aws_ecs_container_definition": ecs.DataSourceContainerDefinition(),
 			"aws_ecs_service":              ecs.DataSourceService(),
+			"aws_ecs_services":             ecs.DataSourceServices(),
 			"aws_ecs_task_definition":      ecs.DataSourceTaskDefinition(),
+			"aws_ecs_task_definitions":     ecs.DataSourceTaskDefinitions(),
+			"aws_ecs_task_execution":       ecs.DataSourceTaskExecution(),
 
 			"aws_efs_access_point":  efs.DataSourceAccessPoint(),
 			"aws_efs_access_points": efs.DataSourceAccessPoints(),
@@ -649,7 +661,10 @@ func Provider() *schema.Provider {
 			"aws_lb":                elbv2.DataSourceLoadBalancer(),
 			"aws_lb_hosted_zone_id": elbv2.DataSourceHostedZoneID(),
 			"aws_lb_listener":       elbv2.DataSourceListener(),
+			"aws_lb_listener_rules": elbv2.DataSourceListenerRules(),
 			"aws_lb_target_group":   elbv2.DataSourceTargetGroup(),
+			"aws_lb_target_groups":  elbv2.DataSourceTargetGroups(),
+			"aws_lbs":               elbv2.DataSourceLoadBalancers(),
 
 			"aws_emr_release_labels": emr.DataSourceReleaseLabels(),
 
@@ -667,21 +682,22 @@ func Provider() *schema.Provider {
 
 			"aws_guardduty_detector": guardduty.DataSourceDetector(),
 
-			"aws_iam_account_alias":           iam.DataSourceAccountAlias(),
-			"aws_iam_group":                   iam.DataSourceGroup(),
-			"aws_iam_instance_profile":        iam.DataSourceInstanceProfile(),
-			"aws_iam_instance_profiles":       iam.DataSourceInstanceProfiles(),
-			"aws_iam_openid_connect_provider": iam.DataSourceOpenIDConnectProvider(),
-			"aws_iam_policy":                  iam.DataSourcePolicy(),
-			"aws_iam_policy_document":         iam.DataSourcePolicyDocument(),
-			"aws_iam_role":                    iam.DataSourceRole(),
-			"aws_iam_roles":                   iam.DataSourceRoles(),
-			"aws_iam_saml_provider":           iam.DataSourceSAMLProvider(),
-			"aws_iam_server_certificate":      iam.DataSourceServerCertificate(),
-			"aws_iam_session_context":         iam.DataSourceSessionContext(),
-			"aws_iam_user":                    iam.DataSourceUser(),
-			"aws_iam_user_ssh_key":            iam.DataSourceUserSSHKey(),
-			"aws_iam_users":                   iam.DataSourceUsers(),
+			"aws_iam_account_alias":               iam.DataSourceAccountAlias(),
+			"aws_iam_group":                       iam.DataSourceGroup(),
+			"aws_iam_instance_profile":            iam.DataSourceInstanceProfile(),
+			"aws_iam_instance_profiles":           iam.DataSourceInstanceProfiles(),
+			"aws_iam_openid_connect_provider":     iam.DataSourceOpenIDConnectProvider(),
+			"aws_iam_policy":                      iam.DataSourcePolicy(),
+			"aws_iam_policy_document":             iam.DataSourcePolicyDocument(),
+			"aws_iam_principal_policy_simulation": iam.DataSourcePrincipalPolicySimulation(),
+			"aws_iam_role":                        iam.DataSourceRole(),
+			"aws_iam_roles":                       iam.DataSourceRoles(),
+			"aws_iam_saml_provider":               iam.DataSourceSAMLProvider(),
+			"aws_iam_server_certificate":          iam.DataSourceServerCertificate(),
+			"aws_iam_session_context":             iam.DataSourceSessionContext(),
+			"aws_iam_user":                        iam.DataSourceUser(),
+			"aws_iam_user_ssh_key":                iam.DataSourceUserSSHKey(),
+			"aws_iam_users":                       iam.DataSourceUsers(),
 
 			"aws_identitystore_group": identitystore.DataSourceGroup(),
 			"aws_identitystore_user":  identitystore.DataSourceUser(),
@@ -811,19 +827,20 @@ func Provider() *schema.Provider {
 			"aws_ses_domain_identity":         ses.DataSourceDomainIdentity(),
 			"aws_ses_email_identity":          ses.DataSourceEmailIdentity(),
 
-			"aws_db_cluster_snapshot":       rds.DataSourceClusterSnapshot(),
-			"aws_db_event_categories":       rds.DataSourceEventCategories(),
-			"aws_db_instance":               rds.DataSourceInstance(),
-			"aws_db_instances":              rds.DataSourceInstances(),
-			"aws_db_proxy":                  rds.DataSourceProxy(),
-			"aws_db_snapshot":               rds.DataSourceSnapshot(),
-			"aws_db_subnet_group":           rds.DataSourceSubnetGroup(),
-			"aws_rds_certificate":           rds.DataSourceCertificate(),
-			"aws_rds_cluster":               rds.DataSourceCluster(),
-			"aws_rds_clusters":              rds.DataSourceClusters(),
-			"aws_rds":                       rds.DataSourceRds(),
-			"aws_rds_engine_version":        rds.DataSourceEngineVersion(),
-			"aws_rds_orderable_db_instance": rds.DataSourceOrderableInstance(),
+			"aws_db_cluster_snapshot":            rds.DataSourceClusterSnapshot(),
+			"aws_db_event_categories":            rds.DataSourceEventCategories(),
+			"aws_db_instance":                    rds.DataSourceInstance(),
+			"aws_db_instances":                   rds.DataSourceInstances(),
+			"aws_db_proxy":                       rds.DataSourceProxy(),
+			"aws_db_snapshot":                    rds.DataSourceSnapshot(),
+			"aws_db_subnet_group":                rds.DataSourceSubnetGroup(),
+			"aws_rds_certificate":                rds.DataSourceCertificate(),
+			"aws_rds_cluster":                    rds.DataSourceCluster(),
+			"aws_rds_clusters":                   rds.DataSourceClusters(),
+			"aws_rds":                            rds.DataSourceRds(),
+			"aws_rds_engine_version":             rds.DataSourceEngineVersion(),
+			"aws_rds_orderable_db_instance":      rds.DataSourceOrderableInstance(),
+			"aws_rds_reserved_instance_offering": rds.DataSourceReservedInstanceOffering(),
 
 			"aws_redshift_cluster":             redshift.DataSourceCluster(),
 			"aws_redshift_cluster_credentials": redshift.DataSourceClusterCredentials(),
@@ -1035,14 +1052,15 @@ func Provider() *schema.Provider {
 			"aws_athena_named_query":  athena.ResourceNamedQuery(),
 			"aws_athena_workgroup":    athena.ResourceWorkGroup(),
 
-			"aws_autoscaling_attachment":     autoscaling.ResourceAttachment(),
-			"aws_autoscaling_group":          autoscaling.ResourceGroup(),
-			"aws_autoscaling_group_tag":      autoscaling.ResourceGroupTag(),
-			"aws_autoscaling_lifecycle_hook": autoscaling.ResourceLifecycleHook(),
-			"aws_autoscaling_notification":   autoscaling.ResourceNotification(),
-			"aws_autoscaling_policy":         autoscaling.ResourcePolicy(),
-			"aws_autoscaling_schedule":       autoscaling.ResourceSchedule(),
-			"aws_launch_configuration":       autoscaling.ResourceLaunchConfiguration(),
+			"aws_autoscaling_attachment":       autoscaling.ResourceAttachment(),
+			"aws_autoscaling_group":            autoscaling.ResourceGroup(),
+			"aws_autoscaling_group_tag":        autoscaling.ResourceGroupTag(),
+			"aws_autoscaling_instance_refresh": autoscaling.ResourceInstanceRefresh(),
+			"aws_autoscaling_lifecycle_hook":   autoscaling.ResourceLifecycleHook(),
+			"aws_autoscaling_notification":     autoscaling.ResourceNotification(),
+			"aws_autoscaling_policy":           autoscaling.ResourcePolicy(),
+			"aws_autoscaling_schedule":         autoscaling.ResourceSchedule(),
+			"aws_launch_configuration":         autoscaling.ResourceLaunchConfiguration(),
 
 			"aws_autoscalingplans_scaling_plan": autoscalingplans.ResourceScalingPlan(),
 
@@ -1299,6 +1317,7 @@ func Provider() *schema.Provider {
 			"aws_default_vpc_dhcp_options":                         ec2.ResourceDefaultVPCDHCPOptions(),
 			"aws_ebs_default_kms_key":                              ec2.ResourceEBSDefaultKMSKey(),
 			"aws_ebs_encryption_by_default":                        ec2.ResourceEBSEncryptionByDefault(),
+			"aws_ebs_fast_snapshot_restore":                        ec2.ResourceEBSFastSnapshotRestore(),
 			"aws_ebs_snapshot":                                     ec2.ResourceEBSSnapshot(),
 			"aws_ebs_snapshot_copy":                                ec2.ResourceEBSSnapshotCopy(),
 			"aws_ebs_snapshot_import":                              ec2.ResourceEBSSnapshotImport(),
@@ -1312,6 +1331,7 @@ func Provider() *schema.Provider {
 			"aws_ec2_client_vpn_route":                             ec2.ResourceClientVPNRoute(),
 			"aws_ec2_fleet":                                        ec2.ResourceFleet(),
 			"aws_ec2_host":                                         ec2.ResourceHost(),
+			"aws_ec2_instance_state":                               ec2.ResourceInstanceState(),
 			"aws_ec2_local_gateway_route":                          ec2.ResourceLocalGatewayRoute(),
 			"aws_ec2_local_gateway_route_table_vpc_association":    ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
 			"aws_ec2_managed_prefix_list":                          ec2.ResourceManagedPrefixList(),
@@ -1356,6 +1376,7 @@ func Provider() *schema.Provider {
 			"aws_network_acl_rule":                                 ec2.ResourceNetworkACLRule(),
 			"aws_network_interface":                                ec2.ResourceNetworkInterface(),
 			"aws_network_interface_attachment":                     ec2.ResourceNetworkInterfaceAttachment(),
+			"aws_network_interface_permission":                     ec2.ResourceNetworkInterfacePermission(),
 			"aws_network_interface_sg_attachment":                  ec2.ResourceNetworkInterfaceSGAttachment(),
 			"aws_placement_group":                                  ec2.ResourcePlacementGroup(),
 			"aws_route":                                            ec2.ResourceRoute(),
@@ -1393,6 +1414,8 @@ func Provider() *schema.Provider {
 			"aws_vpc_peering_connection":                           ec2.ResourceVPCPeeringConnection(),
 			"aws_vpc_peering_connection_accepter":                  ec2.ResourceVPCPeeringConnectionAccepter(),
 			"aws_vpc_peering_connection_options":                   ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpc_security_group_egress_rule":                   ec2.ResourceSecurityGroupEgressRule(),
+			"aws_vpc_security_group_ingress_rule":                  ec2.ResourceSecurityGroupIngressRule(),
 			"aws_vpn_connection":                                   ec2.ResourceVPNConnection(),
 			"aws_vpn_connection_route":                             ec2.ResourceVPNConnectionRoute(),
 			"aws_vpn_gateway":                                      ec2.ResourceVPNGateway(),
@@ -1553,32 +1576,38 @@ func Provider() *schema.Provider {
 			"aws_guardduty_publishing_destination":     guardduty.ResourcePublishingDestination(),
 			"aws_guardduty_threatintelset":             guardduty.ResourceThreatintelset(),
 
-			"aws_iam_access_key":                  iam.ResourceAccessKey(),
-			"aws_iam_account_alias":               iam.ResourceAccountAlias(),
-			"aws_iam_account_password_policy":     iam.ResourceAccountPasswordPolicy(),
-			"aws_iam_group":                       iam.ResourceGroup(),
-			"aws_iam_group_membership":            iam.ResourceGroupMembership(),
-			"aws_iam_group_policy":                iam.ResourceGroupPolicy(),
-			"aws_iam_group_policy_attachment":     iam.ResourceGroupPolicyAttachment(),
-			"aws_iam_instance_profile":            iam.ResourceInstanceProfile(),
-			"aws_iam_openid_connect_provider":     iam.ResourceOpenIDConnectProvider(),
-			"aws_iam_policy":                      iam.ResourcePolicy(),
-			"aws_iam_policy_attachment":           iam.ResourcePolicyAttachment(),
-			"aws_iam_role":                        iam.ResourceRole(),
-			"aws_iam_role_policy":                 iam.ResourceRolePolicy(),
-			"aws_iam_role_policy_attachment":      iam.ResourceRolePolicyAttachment(),
-			"aws_iam_saml_provider":               iam.ResourceSAMLProvider(),
-			"aws_iam_server_certificate":          iam.ResourceServerCertificate(),
-			"aws_iam_service_linked_role":         iam.ResourceServiceLinkedRole(),
-			"aws_iam_service_specific_credential": iam.ResourceServiceSpecificCredential(),
-			"aws_iam_signing_certificate":         iam.ResourceSigningCertificate(),
-			"aws_iam_user":                        iam.ResourceUser(),
-			"aws_iam_user_group_membership":       iam.ResourceUserGroupMembership(),
-			"aws_iam_user_login_profile":          iam.ResourceUserLoginProfile(),
-			"aws_iam_user_policy":                 iam.ResourceUserPolicy(),
-			"aws_iam_user_policy_attachment":      iam.ResourceUserPolicyAttachment(),
-			"aws_iam_user_ssh_key":                iam.ResourceUserSSHKey(),
-			"aws_iam_virtual_mfa_device":          iam.ResourceVirtualMFADevice(),
+			"aws_iam_access_key":                         iam.ResourceAccessKey(),
+			"aws_iam_account_alias":                      iam.ResourceAccountAlias(),
+			"aws_iam_account_password_policy":            iam.ResourceAccountPasswordPolicy(),
+			"aws_iam_group":                              iam.ResourceGroup(),
+			"aws_iam_group_membership":                   iam.ResourceGroupMembership(),
+			"aws_iam_group_policies_exclusive":           iam.ResourceGroupPoliciesExclusive(),
+			"aws_iam_group_policy":                       iam.ResourceGroupPolicy(),
+			"aws_iam_group_policy_attachment":            iam.ResourceGroupPolicyAttachment(),
+			"aws_iam_group_policy_attachments_exclusive": iam.ResourceGroupPolicyAttachmentsExclusive(),
+			"aws_iam_instance_profile":                   iam.ResourceInstanceProfile(),
+			"aws_iam_openid_connect_provider":            iam.ResourceOpenIDConnectProvider(),
+			"aws_iam_policy":                             iam.ResourcePolicy(),
+			"aws_iam_policy_attachment":                  iam.ResourcePolicyAttachment(),
+			"aws_iam_role":                               iam.ResourceRole(),
+			"aws_iam_role_policies_exclusive":            iam.ResourceRolePoliciesExclusive(),
+			"aws_iam_role_policy":                        iam.ResourceRolePolicy(),
+			"aws_iam_role_policy_attachment":             iam.ResourceRolePolicyAttachment(),
+			"aws_iam_role_policy_attachments_exclusive":  iam.ResourceRolePolicyAttachmentsExclusive(),
+			"aws_iam_saml_provider":                      iam.ResourceSAMLProvider(),
+			"aws_iam_server_certificate":                 iam.ResourceServerCertificate(),
+			"aws_iam_service_linked_role":                iam.ResourceServiceLinkedRole(),
+			"aws_iam_service_specific_credential":        iam.ResourceServiceSpecificCredential(),
+			"aws_iam_signing_certificate":                iam.ResourceSigningCertificate(),
+			"aws_iam_user":                               iam.ResourceUser(),
+			"aws_iam_user_group_membership":              iam.ResourceUserGroupMembership(),
+			"aws_iam_user_login_profile":                 iam.ResourceUserLoginProfile(),
+			"aws_iam_user_policies_exclusive":            iam.ResourceUserPoliciesExclusive(),
+			"aws_iam_user_policy":                        iam.ResourceUserPolicy(),
+			"aws_iam_user_policy_attachment":             iam.ResourceUserPolicyAttachment(),
+			"aws_iam_user_policy_attachments_exclusive":  iam.ResourceUserPolicyAttachmentsExclusive(),
+			"aws_iam_user_ssh_key":                       iam.ResourceUserSSHKey(),
+			"aws_iam_virtual_mfa_device":                 iam.ResourceVirtualMFADevice(),
 
 			"aws_imagebuilder_component":                    imagebuilder.ResourceComponent(),
 			"aws_imagebuilder_container_recipe":             imagebuilder.ResourceContainerRecipe(),
@@ -1813,7 +1842,9 @@ func Provider() *schema.Provider {
 			"aws_rds_cluster_instance":                      rds.ResourceClusterInstance(),
 			"aws_rds_cluster_parameter_group":               rds.ResourceClusterParameterGroup(),
 			"aws_rds_cluster_role_association":              rds.ResourceClusterRoleAssociation(),
+			"aws_rds_export_task":                           rds.ResourceExportTask(),
 			"aws_rds_global_cluster":                        rds.ResourceGlobalCluster(),
+			"aws_rds_reserved_instance":                     rds.ResourceReservedInstance(),
 
 			"aws_redshift_authentication_profile":        redshift.ResourceAuthenticationProfile(),
 			"aws_redshift_cluster":                       redshift.ResourceCluster(),
@@ -1891,6 +1922,7 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket_server_side_encryption_configuration": s3.ResourceBucketServerSideEncryptionConfiguration(),
 			"aws_s3_bucket_versioning":                           s3.ResourceBucketVersioning(),
 			"aws_s3_bucket_website_configuration":                s3.ResourceBucketWebsiteConfiguration(),
+			"aws_s3_directory_sync":                              s3.ResourceDirectorySync(),
 			"aws_s3_object":                                      s3.ResourceObject(),
 			"aws_s3_object_copy":                                 s3.ResourceObjectCopy(),
 			"aws_s3_bucket_object":                               s3.ResourceBucketObject(), // DEPRECATED: use aws_s3_object instead
@@ -1905,6 +1937,7 @@ func Provider() *schema.Provider {
 			"aws_s3control_multi_region_access_point_policy":  s3control.ResourceMultiRegionAccessPointPolicy(),
 			"aws_s3control_object_lambda_access_point":        s3control.ResourceObjectLambdaAccessPoint(),
 			"aws_s3control_object_lambda_access_point_policy": s3control.ResourceObjectLambdaAccessPointPolicy(),
+			"aws_s3control_storage_lens_configuration":        s3control.ResourceStorageLensConfiguration(),
 
 			"aws_s3outposts_endpoint": s3outposts.ResourceEndpoint(),
 
@@ -2123,6 +2156,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
 		Endpoints:                      make(map[string]string),
 		HTTPProxy:                      d.Get("http_proxy").(string),
+		HTTPTransportWrapper:           conns.HTTPTransportWrapperFromContext(ctx),
 		IgnoreTagsConfig:               expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     d.Get("max_retries").(int),
@@ -0,0 +1,344 @@
+package autoscaling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const instanceRefreshResourceIDSeparator = ","
+
+func ResourceInstanceRefresh() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInstanceRefreshCreate,
+		ReadWithoutTimeout:   resourceInstanceRefreshRead,
+		DeleteWithoutTimeout: resourceInstanceRefreshDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"autoscaling_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_refresh_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instances_to_update": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"percentage_complete": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"preferences": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"checkpoint_delay": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableIntAtLeast(0),
+						},
+						"checkpoint_percentages": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"instance_warmup": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableIntAtLeast(0),
+						},
+						"min_healthy_percentage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      90,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"skip_matching": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      autoscaling.RefreshStrategyRolling,
+				ValidateFunc: validation.StringInSlice(autoscaling.RefreshStrategy_Values(), false),
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceInstanceRefreshCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AutoScalingConn
+
+	name := d.Get("autoscaling_group_name").(string)
+	input := expandStartInstanceRefreshInput(name, map[string]interface{}{
+		"preferences": d.Get("preferences").([]interface{}),
+		"strategy":    d.Get("strategy").(string),
+	})
+
+	log.Printf("[DEBUG] Starting Auto Scaling Group instance refresh: %s", input)
+	output, err := conn.StartInstanceRefreshWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("starting Auto Scaling Group (%s) instance refresh: %s", name, err)
+	}
+
+	id := aws.StringValue(output.InstanceRefreshId)
+	d.SetId(InstanceRefreshCreateResourceID(name, id))
+
+	if _, err := waitInstanceRefreshCompleted(ctx, conn, name, id, d.Timeout(schema.TimeoutCreate)); err != nil {
+		if ctx.Err() != nil {
+			// The Terraform run was interrupted. Don't leave the refresh running unobserved.
+			log.Printf("[INFO] Cancelling Auto Scaling Group (%s) instance refresh (%s)", name, id)
+			_, cancelErr := conn.CancelInstanceRefresh(&autoscaling.CancelInstanceRefreshInput{
+				AutoScalingGroupName: aws.String(name),
+			})
+
+			if cancelErr != nil && !tfawserr.ErrCodeEquals(cancelErr, autoscaling.ErrCodeActiveInstanceRefreshNotFoundFault) {
+				log.Printf("[WARN] Cancelling Auto Scaling Group (%s) instance refresh (%s): %s", name, id, cancelErr)
+			}
+		}
+
+		return diag.Errorf("waiting for Auto Scaling Group (%s) instance refresh (%s) complete: %s", name, id, err)
+	}
+
+	return resourceInstanceRefreshRead(ctx, d, meta)
+}
+
+func resourceInstanceRefreshRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AutoScalingConn
+
+	name, id, err := InstanceRefreshParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindInstanceRefreshByTwoPartKey(conn, name, id)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Auto Scaling Group (%s) instance refresh (%s) not found, removing from state", name, id)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Auto Scaling Group (%s) instance refresh (%s): %s", name, id, err)
+	}
+
+	d.Set("autoscaling_group_name", output.AutoScalingGroupName)
+	if output.EndTime != nil {
+		d.Set("end_time", aws.TimeValue(output.EndTime).Format(time.RFC3339))
+	} else {
+		d.Set("end_time", nil)
+	}
+	d.Set("instance_refresh_id", output.InstanceRefreshId)
+	d.Set("instances_to_update", output.InstancesToUpdate)
+	d.Set("percentage_complete", output.PercentageComplete)
+	if output.Preferences != nil {
+		if err := d.Set("preferences", []interface{}{flattenRefreshPreferences(output.Preferences)}); err != nil {
+			return diag.Errorf("setting preferences: %s", err)
+		}
+	} else {
+		d.Set("preferences", nil)
+	}
+	if output.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(output.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set("start_time", nil)
+	}
+	d.Set("status", output.Status)
+	d.Set("status_reason", output.StatusReason)
+	// Rolling is the only instance refresh strategy and is not returned by the API.
+	d.Set("strategy", autoscaling.RefreshStrategyRolling)
+
+	return nil
+}
+
+func resourceInstanceRefreshDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AutoScalingConn
+
+	name, id, err := InstanceRefreshParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindInstanceRefreshByTwoPartKey(conn, name, id)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Auto Scaling Group (%s) instance refresh (%s): %s", name, id, err)
+	}
+
+	// Finished instance refreshes cannot be removed; only in-flight refreshes can be cancelled.
+	switch aws.StringValue(output.Status) {
+	case autoscaling.InstanceRefreshStatusPending, autoscaling.InstanceRefreshStatusInProgress:
+		if err := cancelInstanceRefresh(conn, name); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func InstanceRefreshCreateResourceID(autoScalingGroupName, instanceRefreshID string) string {
+	parts := []string{autoScalingGroupName, instanceRefreshID}
+	id := strings.Join(parts, instanceRefreshResourceIDSeparator)
+
+	return id
+}
+
+func InstanceRefreshParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, instanceRefreshResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected AUTOSCALING-GROUP-NAME%[2]sINSTANCE-REFRESH-ID", id, instanceRefreshResourceIDSeparator)
+}
+
+func FindInstanceRefreshByTwoPartKey(conn *autoscaling.AutoScaling, name, id string) (*autoscaling.InstanceRefresh, error) {
+	input := &autoscaling.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: aws.String(name),
+		InstanceRefreshIds:   aws.StringSlice([]string{id}),
+	}
+
+	output, err := findInstanceRefresh(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.InstanceRefreshId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func waitInstanceRefreshCompleted(ctx context.Context, conn *autoscaling.AutoScaling, name, id string, timeout time.Duration) (*autoscaling.InstanceRefresh, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			autoscaling.InstanceRefreshStatusInProgress,
+			autoscaling.InstanceRefreshStatusPending,
+		},
+		Target:     []string{autoscaling.InstanceRefreshStatusSuccessful},
+		Refresh:    statusInstanceRefresh(conn, name, id),
+		Timeout:    timeout,
+		MinTimeout: 15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenRefreshPreferences(apiObject *autoscaling.RefreshPreferences) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CheckpointDelay; v != nil {
+		tfMap["checkpoint_delay"] = strconv.FormatInt(aws.Int64Value(v), 10)
+	}
+
+	if v := apiObject.CheckpointPercentages; v != nil {
+		tfMap["checkpoint_percentages"] = flex.FlattenInt64List(v)
+	}
+
+	if v := apiObject.InstanceWarmup; v != nil {
+		tfMap["instance_warmup"] = strconv.FormatInt(aws.Int64Value(v), 10)
+	}
+
+	if v := apiObject.MinHealthyPercentage; v != nil {
+		tfMap["min_healthy_percentage"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.SkipMatching; v != nil {
+		tfMap["skip_matching"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
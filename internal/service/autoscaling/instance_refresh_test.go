@@ -0,0 +1,136 @@
+package autoscaling_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
+)
+
+func TestAccAutoScalingInstanceRefresh_basic(t *testing.T) {
+	var instanceRefresh autoscaling.InstanceRefresh
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_autoscaling_instance_refresh.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceRefreshConfig_basic(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceRefreshExists(resourceName, &instanceRefresh),
+					resource.TestCheckResourceAttrPair(resourceName, "autoscaling_group_name", "aws_autoscaling_group.test", "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "end_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_refresh_id"),
+					resource.TestCheckResourceAttr(resourceName, "percentage_complete", "100"),
+					resource.TestCheckResourceAttr(resourceName, "preferences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "preferences.0.min_healthy_percentage", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, "status", autoscaling.InstanceRefreshStatusSuccessful),
+					resource.TestCheckResourceAttr(resourceName, "strategy", autoscaling.RefreshStrategyRolling),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				// Triggers only exist in configuration.
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+			{
+				Config: testAccInstanceRefreshConfig_basic(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceRefreshRecreated(resourceName, &instanceRefresh),
+					resource.TestCheckResourceAttr(resourceName, "status", autoscaling.InstanceRefreshStatusSuccessful),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceRefreshExists(n string, v *autoscaling.InstanceRefresh) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Auto Scaling Group instance refresh ID is set")
+		}
+
+		name, id, err := tfautoscaling.InstanceRefreshParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+
+		output, err := tfautoscaling.FindInstanceRefreshByTwoPartKey(conn, name, id)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckInstanceRefreshRecreated(n string, before *autoscaling.InstanceRefresh) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var after autoscaling.InstanceRefresh
+
+		if err := testAccCheckInstanceRefreshExists(n, &after)(s); err != nil {
+			return err
+		}
+
+		if before.InstanceRefreshId == nil || after.InstanceRefreshId == nil || *before.InstanceRefreshId == *after.InstanceRefreshId {
+			return fmt.Errorf("Auto Scaling Group instance refresh was not restarted")
+		}
+
+		return nil
+	}
+}
+
+func testAccInstanceRefreshConfig_basic(rName, trigger string) string {
+	return acctest.ConfigCompose(testAccGroupLaunchTemplateBaseConfig(rName, "t3.nano"), fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones = [data.aws_availability_zones.available.names[0]]
+  max_size           = 1
+  min_size           = 1
+  desired_capacity   = 1
+  name               = %[1]q
+
+  launch_template {
+    id      = aws_launch_template.test.id
+    version = aws_launch_template.test.default_version
+  }
+}
+
+resource "aws_autoscaling_instance_refresh" "test" {
+  autoscaling_group_name = aws_autoscaling_group.test.name
+
+  preferences {
+    instance_warmup        = 0
+    min_healthy_percentage = 0
+  }
+
+  triggers = {
+    revision = %[2]q
+  }
+}
+`, rName, trigger))
+}
@@ -0,0 +1,171 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceEBSFastSnapshotRestore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEBSFastSnapshotRestoreCreate,
+		Read:   resourceEBSFastSnapshotRestoreRead,
+		Delete: resourceEBSFastSnapshotRestoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"owner_alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceEBSFastSnapshotRestoreCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	availabilityZone := d.Get("availability_zone").(string)
+	snapshotID := d.Get("snapshot_id").(string)
+	id := EBSFastSnapshotRestoreCreateResourceID(availabilityZone, snapshotID)
+	input := &ec2.EnableFastSnapshotRestoresInput{
+		AvailabilityZones: aws.StringSlice([]string{availabilityZone}),
+		SourceSnapshotIds: aws.StringSlice([]string{snapshotID}),
+	}
+
+	log.Printf("[DEBUG] Creating EBS Fast Snapshot Restore: %s", input)
+	output, err := conn.EnableFastSnapshotRestores(input)
+
+	if err == nil && output != nil {
+		err = EnableFastSnapshotRestoreStateErrorItemsError(output.Unsuccessful)
+	}
+
+	if err != nil {
+		return fmt.Errorf("creating EBS Fast Snapshot Restore (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := WaitFastSnapshotRestoreCreated(conn, availabilityZone, snapshotID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("waiting for EBS Fast Snapshot Restore (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceEBSFastSnapshotRestoreRead(d, meta)
+}
+
+func resourceEBSFastSnapshotRestoreRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	availabilityZone, snapshotID, err := EBSFastSnapshotRestoreParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	fsr, err := FindFastSnapshotRestoreByTwoPartKey(conn, availabilityZone, snapshotID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EBS Fast Snapshot Restore %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EBS Fast Snapshot Restore (%s): %w", d.Id(), err)
+	}
+
+	d.Set("availability_zone", fsr.AvailabilityZone)
+	d.Set("owner_alias", fsr.OwnerAlias)
+	d.Set("owner_id", fsr.OwnerId)
+	d.Set("snapshot_id", fsr.SnapshotId)
+	d.Set("state", fsr.State)
+
+	return nil
+}
+
+func resourceEBSFastSnapshotRestoreDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	availabilityZone, snapshotID, err := EBSFastSnapshotRestoreParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting EBS Fast Snapshot Restore: %s", d.Id())
+	output, err := conn.DisableFastSnapshotRestores(&ec2.DisableFastSnapshotRestoresInput{
+		AvailabilityZones: aws.StringSlice([]string{availabilityZone}),
+		SourceSnapshotIds: aws.StringSlice([]string{snapshotID}),
+	})
+
+	if err == nil && output != nil {
+		err = DisableFastSnapshotRestoreStateErrorItemsError(output.Unsuccessful)
+	}
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidSnapshotNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting EBS Fast Snapshot Restore (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitFastSnapshotRestoreDeleted(conn, availabilityZone, snapshotID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("waiting for EBS Fast Snapshot Restore (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const ebsFastSnapshotRestoreResourceIDSeparator = ","
+
+func EBSFastSnapshotRestoreCreateResourceID(availabilityZone, snapshotID string) string {
+	parts := []string{availabilityZone, snapshotID}
+	id := strings.Join(parts, ebsFastSnapshotRestoreResourceIDSeparator)
+
+	return id
+}
+
+func EBSFastSnapshotRestoreParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, ebsFastSnapshotRestoreResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected AvailabilityZone%[2]sSnapshotID", id, ebsFastSnapshotRestoreResourceIDSeparator)
+}
@@ -72,8 +72,9 @@ func ResourceEBSSnapshot() *schema.Resource {
 				Computed: true,
 			},
 			"permanent_restore": {
-				Type:     schema.TypeBool,
-				Optional: true,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"temporary_restore_days"},
 			},
 			"storage_tier": {
 				Type:         schema.TypeString,
@@ -84,8 +85,10 @@ func ResourceEBSSnapshot() *schema.Resource {
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"temporary_restore_days": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntBetween(1, 180),
+				ConflictsWith: []string{"permanent_restore"},
 			},
 			"volume_id": {
 				Type:     schema.TypeString,
@@ -193,7 +196,13 @@ func resourceEBSSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("outpost_arn", snapshot.OutpostArn)
 	d.Set("owner_alias", snapshot.OwnerAlias)
 	d.Set("owner_id", snapshot.OwnerId)
-	d.Set("storage_tier", snapshot.StorageTier)
+	storageTier, err := snapshotStorageTier(conn, snapshot)
+
+	if err != nil {
+		return fmt.Errorf("reading EBS Snapshot (%s) Storage Tier: %w", d.Id(), err)
+	}
+
+	d.Set("storage_tier", storageTier)
 	d.Set("volume_id", snapshot.VolumeId)
 	d.Set("volume_size", snapshot.VolumeSize)
 
@@ -281,3 +290,31 @@ func resourceEBSSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// snapshotStorageTier returns the storage tier of the specified snapshot.
+// A snapshot being restored from the archive tier is reported as being in the standard tier
+// as restores can take up to 72 hours to complete.
+func snapshotStorageTier(conn *ec2.EC2, snapshot *ec2.Snapshot) (string, error) {
+	storageTier := aws.StringValue(snapshot.StorageTier)
+
+	if storageTier != ec2.StorageTierArchive {
+		return storageTier, nil
+	}
+
+	output, err := FindSnapshotTierStatusBySnapshotID(conn, aws.StringValue(snapshot.SnapshotId))
+
+	if tfresource.NotFound(err) {
+		return storageTier, nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	switch aws.StringValue(output.LastTieringOperationStatus) {
+	case ec2.TieringOperationStatusPermanentRestoreInProgress, ec2.TieringOperationStatusTemporaryRestoreInProgress:
+		return TargetStorageTierStandard, nil
+	}
+
+	return storageTier, nil
+}
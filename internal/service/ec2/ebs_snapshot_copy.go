@@ -65,8 +65,9 @@ func ResourceEBSSnapshotCopy() *schema.Resource {
 				Computed: true,
 			},
 			"permanent_restore": {
-				Type:     schema.TypeBool,
-				Optional: true,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"temporary_restore_days"},
 			},
 			"source_region": {
 				Type:     schema.TypeString,
@@ -87,8 +88,10 @@ func ResourceEBSSnapshotCopy() *schema.Resource {
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"temporary_restore_days": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntBetween(1, 180),
+				ConflictsWith: []string{"permanent_restore"},
 			},
 			"volume_id": {
 				Type:     schema.TypeString,
@@ -149,8 +149,9 @@ func ResourceEBSSnapshotImport() *schema.Resource {
 				Computed: true,
 			},
 			"permanent_restore": {
-				Type:     schema.TypeBool,
-				Optional: true,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"temporary_restore_days"},
 			},
 			"role_name": {
 				Type:     schema.TypeString,
@@ -167,8 +168,10 @@ func ResourceEBSSnapshotImport() *schema.Resource {
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"temporary_restore_days": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntBetween(1, 180),
+				ConflictsWith: []string{"permanent_restore"},
 			},
 			"volume_id": {
 				Type:     schema.TypeString,
@@ -291,7 +294,13 @@ func resourceEBSSnapshotImportRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("kms_key_id", snapshot.KmsKeyId)
 	d.Set("owner_alias", snapshot.OwnerAlias)
 	d.Set("owner_id", snapshot.OwnerId)
-	d.Set("storage_tier", snapshot.StorageTier)
+	storageTier, err := snapshotStorageTier(conn, snapshot)
+
+	if err != nil {
+		return fmt.Errorf("reading EBS Snapshot (%s) Storage Tier: %w", d.Id(), err)
+	}
+
+	d.Set("storage_tier", storageTier)
 	d.Set("volume_size", snapshot.VolumeSize)
 
 	tags := KeyValueTags(snapshot.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
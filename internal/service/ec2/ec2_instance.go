@@ -1880,25 +1880,7 @@ func modifyInstanceAttributeWithStopStart(conn *ec2.EC2, input *ec2.ModifyInstan
 		return fmt.Errorf("modifying EC2 Instance (%s) attribute: %w", id, err)
 	}
 
-	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/16433.
-	_, err := tfresource.RetryWhenAWSErrMessageContains(propagationTimeout,
-		func() (interface{}, error) {
-			return conn.StartInstances(&ec2.StartInstancesInput{
-				InstanceIds: aws.StringSlice([]string{id}),
-			})
-		},
-		errCodeInvalidParameterValue, "LaunchPlan instance type does not match attribute value",
-	)
-
-	if err != nil {
-		return fmt.Errorf("starting EC2 Instance (%s): %w", id, err)
-	}
-
-	if _, err := WaitInstanceStarted(conn, id, InstanceStartTimeout); err != nil {
-		return fmt.Errorf("waiting for EC2 Instance (%s) start: %w", id, err)
-	}
-
-	return nil
+	return StartInstance(conn, id, InstanceStartTimeout)
 }
 
 func readBlockDevices(d *schema.ResourceData, instance *ec2.Instance, conn *ec2.EC2) error {
@@ -2750,12 +2732,51 @@ func buildInstanceOpts(d *schema.ResourceData, meta interface{}) (*awsInstanceOp
 	return opts, nil
 }
 
+// StartInstance starts an EC2 instance and waits for the instance to start.
+func StartInstance(conn *ec2.EC2, id string, timeout time.Duration) error {
+	log.Printf("[INFO] Starting EC2 Instance: %s", id)
+	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/16433.
+	_, err := tfresource.RetryWhenAWSErrMessageContains(propagationTimeout,
+		func() (interface{}, error) {
+			return conn.StartInstances(&ec2.StartInstancesInput{
+				InstanceIds: aws.StringSlice([]string{id}),
+			})
+		},
+		errCodeInvalidParameterValue, "LaunchPlan instance type does not match attribute value",
+	)
+
+	if err != nil {
+		return fmt.Errorf("starting EC2 Instance (%s): %w", id, err)
+	}
+
+	if _, err := WaitInstanceStarted(conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for EC2 Instance (%s) start: %w", id, err)
+	}
+
+	return nil
+}
+
 // StopInstance stops an EC2 instance and waits for the instance to stop.
 func StopInstance(conn *ec2.EC2, id string, timeout time.Duration) error {
-	log.Printf("[INFO] Stopping EC2 Instance: %s", id)
-	_, err := conn.StopInstances(&ec2.StopInstancesInput{
+	return stopInstanceWithOptions(conn, id, false, false, timeout)
+}
+
+// stopInstanceWithOptions stops, optionally forcing or hibernating, an EC2 instance and waits for the instance to stop.
+func stopInstanceWithOptions(conn *ec2.EC2, id string, force, hibernate bool, timeout time.Duration) error {
+	input := &ec2.StopInstancesInput{
 		InstanceIds: aws.StringSlice([]string{id}),
-	})
+	}
+
+	if force {
+		input.Force = aws.Bool(true)
+	}
+
+	if hibernate {
+		input.Hibernate = aws.Bool(true)
+	}
+
+	log.Printf("[INFO] Stopping EC2 Instance: %s", input)
+	_, err := conn.StopInstances(input)
 
 	if err != nil {
 		return fmt.Errorf("stopping EC2 Instance (%s): %w", id, err)
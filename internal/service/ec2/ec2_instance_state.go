@@ -0,0 +1,160 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceInstanceState() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInstanceStateCreate,
+		Read:   resourceInstanceStateRead,
+		Update: resourceInstanceStateUpdate,
+		Delete: resourceInstanceStateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("force", false)
+				d.Set("hibernate", false)
+				d.Set("instance_id", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(instanceStateTimeout()),
+			Update: schema.DefaultTimeout(instanceStateTimeout()),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"hibernate": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{ec2.InstanceStateNameRunning, ec2.InstanceStateNameStopped}, false),
+			},
+		},
+	}
+}
+
+// instanceStateTimeout returns the default timeout for changing an instance's state.
+// Both create and update may start or stop the instance, so the longer of the two timeouts is used.
+func instanceStateTimeout() time.Duration {
+	if InstanceStartTimeout > InstanceStopTimeout {
+		return InstanceStartTimeout
+	}
+
+	return InstanceStopTimeout
+}
+
+func resourceInstanceStateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instanceID := d.Get("instance_id").(string)
+
+	instance, err := WaitInstanceReady(conn, instanceID, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for EC2 Instance (%s) ready: %w", instanceID, err)
+	}
+
+	if err := updateInstanceState(conn, instance, d.Get("state").(string), d.Get("force").(bool), d.Get("hibernate").(bool), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(instanceID)
+
+	return resourceInstanceStateRead(d, meta)
+}
+
+func resourceInstanceStateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instance, err := FindInstanceByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Instance State %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Instance State (%s): %w", d.Id(), err)
+	}
+
+	d.Set("instance_id", instance.InstanceId)
+	// Any state other than that configured, e.g. after an out-of-band stop or start, is reported as drift.
+	d.Set("state", instance.State.Name)
+
+	return nil
+}
+
+func resourceInstanceStateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("state") {
+		instance, err := WaitInstanceReady(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return fmt.Errorf("error waiting for EC2 Instance (%s) ready: %w", d.Id(), err)
+		}
+
+		if err := updateInstanceState(conn, instance, d.Get("state").(string), d.Get("force").(bool), d.Get("hibernate").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceInstanceStateRead(d, meta)
+}
+
+func resourceInstanceStateDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] EC2 Instance State (%s) not deleted, removing from state", d.Id())
+
+	return nil
+}
+
+// updateInstanceState starts or stops the specified EC2 instance, which must not be in a transitional state,
+// and waits for it to reach the specified state.
+func updateInstanceState(conn *ec2.EC2, instance *ec2.Instance, state string, force, hibernate bool, timeout time.Duration) error {
+	id := aws.StringValue(instance.InstanceId)
+
+	if aws.StringValue(instance.State.Name) == state {
+		return nil
+	}
+
+	switch state {
+	case ec2.InstanceStateNameRunning:
+		return StartInstance(conn, id, timeout)
+	case ec2.InstanceStateNameStopped:
+		if hibernate && (instance.HibernationOptions == nil || !aws.BoolValue(instance.HibernationOptions.Configured)) {
+			return fmt.Errorf("EC2 Instance (%s) cannot be hibernated: hibernation is not enabled", id)
+		}
+
+		return stopInstanceWithOptions(conn, id, force, hibernate, timeout)
+	}
+
+	return fmt.Errorf("unsupported EC2 Instance (%s) state: %s", id, state)
+}
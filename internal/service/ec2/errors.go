@@ -53,6 +53,7 @@ const (
 	errCodeInvalidParameterException                      = "InvalidParameterException"
 	errCodeInvalidParameterValue                          = "InvalidParameterValue"
 	errCodeInvalidPermissionDuplicate                     = "InvalidPermission.Duplicate"
+	errCodeInvalidPermissionIDNotFound                    = "InvalidPermissionID.NotFound"
 	errCodeInvalidPermissionNotFound                      = "InvalidPermission.NotFound"
 	errCodeInvalidPlacementGroupUnknown                   = "InvalidPlacementGroup.Unknown"
 	errCodeInvalidPoolIDNotFound                          = "InvalidPoolID.NotFound"
@@ -62,6 +63,7 @@ const (
 	errCodeInvalidRouteTableIDNotFound                    = "InvalidRouteTableID.NotFound"
 	errCodeInvalidRouteTableIdNotFound                    = "InvalidRouteTableId.NotFound"
 	errCodeInvalidSecurityGroupIDNotFound                 = "InvalidSecurityGroupID.NotFound"
+	errCodeInvalidSecurityGroupRuleIdNotFound             = "InvalidSecurityGroupRuleId.NotFound"
 	errCodeInvalidServiceName                             = "InvalidServiceName"
 	errCodeInvalidSnapshotInUse                           = "InvalidSnapshot.InUse"
 	errCodeInvalidSnapshotNotFound                        = "InvalidSnapshot.NotFound"
@@ -133,6 +135,48 @@ func DeleteFleetsError(apiObjects []*ec2.DeleteFleetErrorItem) error {
 	return errors.ErrorOrNil()
 }
 
+func DisableFastSnapshotRestoreStateErrorItemsError(apiObjects []*ec2.DisableFastSnapshotRestoreErrorItem) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		for _, v := range apiObject.FastSnapshotRestoreStateErrors {
+			if v == nil || v.Error == nil {
+				continue
+			}
+
+			err := awserr.New(aws.StringValue(v.Error.Code), aws.StringValue(v.Error.Message), nil)
+			errors = multierror.Append(errors, fmt.Errorf("%s (%s): %w", aws.StringValue(apiObject.SnapshotId), aws.StringValue(v.AvailabilityZone), err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
+func EnableFastSnapshotRestoreStateErrorItemsError(apiObjects []*ec2.EnableFastSnapshotRestoreErrorItem) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		for _, v := range apiObject.FastSnapshotRestoreStateErrors {
+			if v == nil || v.Error == nil {
+				continue
+			}
+
+			err := awserr.New(aws.StringValue(v.Error.Code), aws.StringValue(v.Error.Message), nil)
+			errors = multierror.Append(errors, fmt.Errorf("%s (%s): %w", aws.StringValue(apiObject.SnapshotId), aws.StringValue(v.AvailabilityZone), err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
 func UnsuccessfulItemError(apiObject *ec2.UnsuccessfulItemError) error {
 	if apiObject == nil {
 		return nil
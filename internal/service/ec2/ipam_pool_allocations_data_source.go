@@ -0,0 +1,175 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceIPAMPoolAllocations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIPAMPoolAllocationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cidr": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			"filter": DataSourceFiltersSchema(),
+			"ipam_pool_allocation_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ipam_pool_allocations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipam_pool_allocation_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ipam_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceIPAMPoolAllocationsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	poolID := d.Get("ipam_pool_id").(string)
+	input := &ec2.GetIpamPoolAllocationsInput{
+		IpamPoolId: aws.String(poolID),
+	}
+
+	if v, ok := d.GetOk("ipam_pool_allocation_id"); ok {
+		input.IpamPoolAllocationId = aws.String(v.(string))
+	}
+
+	input.Filters = append(input.Filters, BuildFiltersDataSource(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	output, err := FindIPAMPoolAllocations(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("reading IPAM Pool (%s) Allocations: %w", poolID, err)
+	}
+
+	// Only return the allocations that overlap the specified CIDR block.
+	if v, ok := d.GetOk("cidr"); ok {
+		cidr := v.(string)
+		var allocations []*ec2.IpamPoolAllocation
+
+		for _, v := range output {
+			if verify.CIDRBlocksOverlap(cidr, aws.StringValue(v.Cidr)) {
+				allocations = append(allocations, v)
+			}
+		}
+
+		output = allocations
+	}
+
+	d.SetId(poolID)
+	if err := d.Set("ipam_pool_allocations", flattenIPAMPoolAllocations(output)); err != nil {
+		return fmt.Errorf("setting ipam_pool_allocations: %w", err)
+	}
+
+	return nil
+}
+
+func flattenIPAMPoolAllocation(apiObject *ec2.IpamPoolAllocation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Cidr; v != nil {
+		tfMap["cidr"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Description; v != nil {
+		tfMap["description"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.IpamPoolAllocationId; v != nil {
+		tfMap["ipam_pool_allocation_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ResourceId; v != nil {
+		tfMap["resource_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ResourceOwner; v != nil {
+		tfMap["resource_owner"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ResourceRegion; v != nil {
+		tfMap["resource_region"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ResourceType; v != nil {
+		tfMap["resource_type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenIPAMPoolAllocations(apiObjects []*ec2.IpamPoolAllocation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenIPAMPoolAllocation(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,121 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIPAMPoolAllocationsDataSource_basic(t *testing.T) {
+	datasourceName := "data.aws_vpc_ipam_pool_allocations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccIPAMPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMPoolAllocationsDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "ipam_pool_id", "aws_vpc_ipam_pool.test", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "ipam_pool_allocations.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(datasourceName, "ipam_pool_allocations.*", map[string]string{
+						"cidr":          "172.2.0.0/28",
+						"resource_type": "custom",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(datasourceName, "ipam_pool_allocations.*", map[string]string{
+						"cidr":          "172.2.0.128/28",
+						"resource_type": "custom",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIPAMPoolAllocationsDataSource_cidr(t *testing.T) {
+	datasourceName := "data.aws_vpc_ipam_pool_allocations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccIPAMPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMPoolAllocationsDataSourceConfig_cidr,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "ipam_pool_allocations.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "ipam_pool_allocations.0.ipam_pool_allocation_id", "aws_vpc_ipam_pool_cidr_allocation.test2", "ipam_pool_allocation_id"),
+					resource.TestCheckResourceAttr(datasourceName, "ipam_pool_allocations.0.cidr", "172.2.0.128/28"),
+				),
+			},
+		},
+	})
+}
+
+const testAccIPAMPoolAllocationsDataSourceConfig_base = `
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  description = "test"
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+}
+
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+  locale         = data.aws_region.current.name
+}
+
+resource "aws_vpc_ipam_pool_cidr" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = "172.2.0.0/24"
+}
+
+resource "aws_vpc_ipam_pool_cidr_allocation" "test1" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = "172.2.0.0/28"
+
+  depends_on = [
+    aws_vpc_ipam_pool_cidr.test
+  ]
+}
+
+resource "aws_vpc_ipam_pool_cidr_allocation" "test2" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = "172.2.0.128/28"
+
+  depends_on = [
+    aws_vpc_ipam_pool_cidr.test
+  ]
+}
+`
+
+var testAccIPAMPoolAllocationsDataSourceConfig_basic = acctest.ConfigCompose(testAccIPAMPoolAllocationsDataSourceConfig_base, `
+data "aws_vpc_ipam_pool_allocations" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+
+  depends_on = [
+    aws_vpc_ipam_pool_cidr_allocation.test1,
+    aws_vpc_ipam_pool_cidr_allocation.test2,
+  ]
+}
+`)
+
+var testAccIPAMPoolAllocationsDataSourceConfig_cidr = acctest.ConfigCompose(testAccIPAMPoolAllocationsDataSourceConfig_base, `
+data "aws_vpc_ipam_pool_allocations" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = "172.2.0.128/25"
+
+  depends_on = [
+    aws_vpc_ipam_pool_cidr_allocation.test1,
+    aws_vpc_ipam_pool_cidr_allocation.test2,
+  ]
+}
+`)
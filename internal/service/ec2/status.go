@@ -1071,6 +1071,22 @@ func StatusNetworkInterfaceAttachmentStatus(conn *ec2.EC2, id string) resource.S
 	}
 }
 
+func StatusNetworkInterfacePermissionState(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindNetworkInterfacePermissionByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.PermissionState.State), nil
+	}
+}
+
 func StatusPlacementGroupState(conn *ec2.EC2, name string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindPlacementGroupByName(conn, name)
@@ -1205,3 +1221,19 @@ func StatusSnapshotStorageTier(conn *ec2.EC2, id string) resource.StateRefreshFu
 		return output, aws.StringValue(output.StorageTier), nil
 	}
 }
+
+func StatusFastSnapshotRestore(conn *ec2.EC2, availabilityZone, snapshotID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFastSnapshotRestoreByTwoPartKey(conn, availabilityZone, snapshotID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
@@ -145,9 +145,15 @@ func init() {
 		F:    sweepNetworkInterfaces,
 		Dependencies: []string{
 			"aws_instance",
+			"aws_network_interface_permission",
 		},
 	})
 
+	resource.AddTestSweepers("aws_network_interface_permission", &resource.Sweeper{
+		Name: "aws_network_interface_permission",
+		F:    sweepNetworkInterfacePermissions,
+	})
+
 	resource.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
 		Name: "aws_ec2_network_insights_path",
 		F:    sweepNetworkInsightsPaths,
@@ -1214,6 +1220,56 @@ func sweepNetworkInterfaces(region string) error {
 	return nil
 }
 
+func sweepNetworkInterfacePermissions(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).EC2Conn
+	input := &ec2.DescribeNetworkInterfacePermissionsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.DescribeNetworkInterfacePermissionsPages(input, func(page *ec2.DescribeNetworkInterfacePermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.NetworkInterfacePermissions {
+			id := aws.StringValue(v.NetworkInterfacePermissionId)
+
+			if v.PermissionState != nil && aws.StringValue(v.PermissionState.State) == ec2.NetworkInterfacePermissionStateCodeRevoked {
+				log.Printf("[INFO] Skipping EC2 Network Interface Permission %s: revoked", id)
+				continue
+			}
+
+			r := ResourceNetworkInterfacePermission()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 Network Interface Permission sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing EC2 Network Interface Permissions (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Network Interface Permissions (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepNetworkInsightsPaths(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
@@ -10,6 +10,10 @@ import (
 
 func TestAccTransitGatewayDataSource_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
+		"Attachments": {
+			"Filter": testAccTransitGatewayAttachmentsDataSource_Filter,
+			"Empty":  testAccTransitGatewayAttachmentsDataSource_empty,
+		},
 		"Connect": {
 			"Filter": testAccTransitGatewayConnectDataSource_Filter,
 			"ID":     testAccTransitGatewayConnectDataSource_ID,
@@ -41,6 +45,18 @@ func TestAccTransitGatewayDataSource_serial(t *testing.T) {
 			"Filter": testAccTransitGatewayRouteTableDataSource_Filter,
 			"ID":     testAccTransitGatewayRouteTableDataSource_ID,
 		},
+		"RouteTableAssociations": {
+			"basic":  testAccTransitGatewayRouteTableAssociationsDataSource_basic,
+			"Filter": testAccTransitGatewayRouteTableAssociationsDataSource_filter,
+		},
+		"RouteTablePropagations": {
+			"basic":  testAccTransitGatewayRouteTablePropagationsDataSource_basic,
+			"Filter": testAccTransitGatewayRouteTablePropagationsDataSource_filter,
+		},
+		"RouteTableRoutes": {
+			"basic":  testAccTransitGatewayRouteTableRoutesDataSource_basic,
+			"Filter": testAccTransitGatewayRouteTableRoutesDataSource_filter,
+		},
 		"RouteTables": {
 			"basic":  testAccTransitGatewayRouteTablesDataSource_basic,
 			"Filter": testAccTransitGatewayRouteTablesDataSource_filter,
@@ -0,0 +1,204 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceTransitGatewayRouteTableRoutes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTransitGatewayRouteTableRoutesRead,
+
+		Schema: map[string]*schema.Schema{
+			// SearchTransitGatewayRoutes requires at least one filter.
+			"filter": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntBetween(5, 1000),
+			},
+			"routes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prefix_list_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transit_gateway_attachments": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"resource_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"transit_gateway_attachment_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"transit_gateway_route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceTransitGatewayRouteTableRoutesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	routeTableID := d.Get("transit_gateway_route_table_id").(string)
+	input := &ec2.SearchTransitGatewayRoutesInput{
+		Filters:                    BuildFiltersDataSource(d.Get("filter").(*schema.Set)),
+		MaxResults:                 aws.Int64(int64(d.Get("max_results").(int))),
+		TransitGatewayRouteTableId: aws.String(routeTableID),
+	}
+
+	output, err := FindTransitGatewayRoutes(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Transit Gateway Route Table (%s) Routes: %w", routeTableID, err)
+	}
+
+	d.SetId(routeTableID)
+	if err := d.Set("routes", flattenTransitGatewayRoutes(output)); err != nil {
+		return fmt.Errorf("setting routes: %w", err)
+	}
+
+	return nil
+}
+
+func flattenTransitGatewayRoute(apiObject *ec2.TransitGatewayRoute) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DestinationCidrBlock; v != nil {
+		tfMap["destination_cidr_block"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PrefixListId; v != nil {
+		tfMap["prefix_list_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.State; v != nil {
+		tfMap["state"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TransitGatewayAttachments; v != nil {
+		tfMap["transit_gateway_attachments"] = flattenTransitGatewayRouteAttachments(v)
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenTransitGatewayRoutes(apiObjects []*ec2.TransitGatewayRoute) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenTransitGatewayRoute(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenTransitGatewayRouteAttachment(apiObject *ec2.TransitGatewayRouteAttachment) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ResourceId; v != nil {
+		tfMap["resource_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ResourceType; v != nil {
+		tfMap["resource_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TransitGatewayAttachmentId; v != nil {
+		tfMap["transit_gateway_attachment_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenTransitGatewayRouteAttachments(apiObjects []*ec2.TransitGatewayRouteAttachment) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenTransitGatewayRouteAttachment(apiObject))
+	}
+
+	return tfList
+}
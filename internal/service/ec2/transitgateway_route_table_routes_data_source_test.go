@@ -0,0 +1,223 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+// transitGatewayRoutesStub serves SearchTransitGatewayRoutes from a fixed number of routes,
+// returning at most MaxResults of them like the real API.
+type transitGatewayRoutesStub struct {
+	ec2iface.EC2API
+
+	count int
+}
+
+func (s transitGatewayRoutesStub) SearchTransitGatewayRoutes(input *ec2.SearchTransitGatewayRoutesInput) (*ec2.SearchTransitGatewayRoutesOutput, error) {
+	output := &ec2.SearchTransitGatewayRoutesOutput{
+		AdditionalRoutesAvailable: aws.Bool(false),
+	}
+
+	for i := 0; i < s.count; i++ {
+		if max := aws.Int64Value(input.MaxResults); max > 0 && int64(i) == max {
+			output.AdditionalRoutesAvailable = aws.Bool(true)
+			break
+		}
+
+		output.Routes = append(output.Routes, &ec2.TransitGatewayRoute{
+			DestinationCidrBlock: aws.String(fmt.Sprintf("10.%d.0.0/16", i)),
+			State:                aws.String(ec2.TransitGatewayRouteStateActive),
+			Type:                 aws.String(ec2.TransitGatewayRouteTypeStatic),
+		})
+	}
+
+	return output, nil
+}
+
+func TestTransitGatewayRouteTableRoutesDataSourceRead(t *testing.T) {
+	testCases := []struct {
+		name          string
+		count         int
+		maxResults    int
+		expectedCount int
+		expectedError *regexp.Regexp
+	}{
+		{
+			name:          "default max_results",
+			count:         20,
+			expectedCount: 20,
+		},
+		{
+			name:          "all routes within max_results",
+			count:         5,
+			maxResults:    5,
+			expectedCount: 5,
+		},
+		{
+			name:          "additional routes available",
+			count:         6,
+			maxResults:    5,
+			expectedError: regexp.MustCompile(`more than 5 routes match`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.Client()
+			client.EC2Conn = fake.ServiceV1(ec2.New, transitGatewayRoutesStub{count: testCase.count}).(*ec2.EC2)
+			r := tfec2.DataSourceTransitGatewayRouteTableRoutes()
+			raw := map[string]interface{}{
+				"filter": []interface{}{
+					map[string]interface{}{
+						"name":   "type",
+						"values": []interface{}{"static"},
+					},
+				},
+				"transit_gateway_route_table_id": "tgw-rtb-12345678",
+			}
+
+			if testCase.maxResults > 0 {
+				raw["max_results"] = testCase.maxResults
+			}
+
+			d := fake.ResourceData(t, r, raw)
+			diags := fake.Read(ctx, r, d, client)
+
+			if testCase.expectedError != nil {
+				if !diags.HasError() {
+					t.Fatal("expected error, got none")
+				}
+
+				if got := diags[0].Summary; !testCase.expectedError.MatchString(got) {
+					t.Fatalf("got error %q, expected to match %q", got, testCase.expectedError)
+				}
+
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := d.Get("routes.#").(int), testCase.expectedCount; got != want {
+				t.Errorf("got %d routes, expected %d", got, want)
+			}
+		})
+	}
+}
+
+func testAccTransitGatewayRouteTableRoutesDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateway_route_table_routes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableRoutesDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "routes.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "routes.*", map[string]string{
+						"destination_cidr_block": "10.1.0.0/16",
+						"state":                  "active",
+						"type":                   "static",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "routes.*", map[string]string{
+						"destination_cidr_block": "10.2.0.0/16",
+						"state":                  "blackhole",
+						"type":                   "static",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTableRoutesDataSource_filter(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateway_route_table_routes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableRoutesDataSourceConfig_filter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "routes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "routes.0.destination_cidr_block", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr(dataSourceName, "routes.0.state", "active"),
+					resource.TestCheckResourceAttr(dataSourceName, "routes.0.transit_gateway_attachments.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "routes.0.transit_gateway_attachments.0.transit_gateway_attachment_id", "aws_ec2_transit_gateway_vpc_attachment.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "routes.0.transit_gateway_attachments.0.resource_type", "vpc"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTableRoutesDataSourceBaseConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableDataSourceBaseConfig(rName), `
+resource "aws_ec2_transit_gateway_route" "test1" {
+  destination_cidr_block         = "10.1.0.0/16"
+  transit_gateway_attachment_id  = aws_ec2_transit_gateway_vpc_attachment.test.id
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+}
+
+resource "aws_ec2_transit_gateway_route" "test2" {
+  destination_cidr_block         = "10.2.0.0/16"
+  blackhole                      = true
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+}
+`)
+}
+
+func testAccTransitGatewayRouteTableRoutesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableRoutesDataSourceBaseConfig(rName), `
+data "aws_ec2_transit_gateway_route_table_routes" "test" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+
+  filter {
+    name   = "type"
+    values = ["static"]
+  }
+
+  depends_on = [aws_ec2_transit_gateway_route.test1, aws_ec2_transit_gateway_route.test2]
+}
+`)
+}
+
+func testAccTransitGatewayRouteTableRoutesDataSourceConfig_filter(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableRoutesDataSourceBaseConfig(rName), `
+data "aws_ec2_transit_gateway_route_table_routes" "test" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+
+  filter {
+    name   = "state"
+    values = ["active"]
+  }
+
+  filter {
+    name   = "route-search.subnet-of-match"
+    values = ["10.0.0.0/8"]
+  }
+
+  depends_on = [aws_ec2_transit_gateway_route.test1, aws_ec2_transit_gateway_route.test2]
+}
+`)
+}
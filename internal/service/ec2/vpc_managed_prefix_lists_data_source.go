@@ -0,0 +1,180 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfilters"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceManagedPrefixLists() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceManagedPrefixListsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": namevaluesfilters.Schema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"include_entries": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"prefix_lists": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address_family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"entries": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cidr": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"max_entries": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceManagedPrefixListsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	input := &ec2.DescribeManagedPrefixListsInput{}
+
+	filters := namevaluesfilters.New(d.Get("filter").(*schema.Set))
+	filters.Add(namevaluesfilters.EC2Tags(tftags.New(d.Get("tags").(map[string]interface{})).Map()))
+
+	if v := filters.EC2Filters(); len(v) > 0 {
+		input.Filters = v
+	}
+
+	output, err := FindManagedPrefixLists(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Managed Prefix Lists: %w", err)
+	}
+
+	includeEntries := d.Get("include_entries").(bool)
+	var prefixListIDs, prefixListNames []string
+	var tfList []interface{}
+
+	for _, v := range output {
+		prefixListID := aws.StringValue(v.PrefixListId)
+		prefixListIDs = append(prefixListIDs, prefixListID)
+		prefixListNames = append(prefixListNames, aws.StringValue(v.PrefixListName))
+
+		tfMap := flattenManagedPrefixList(v)
+
+		if includeEntries {
+			entries, err := FindManagedPrefixListEntriesByID(conn, prefixListID)
+
+			if err != nil {
+				return fmt.Errorf("reading EC2 Managed Prefix List (%s) entries: %w", prefixListID, err)
+			}
+
+			tfMap["entries"] = flattenPrefixListEntries(entries)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("ids", prefixListIDs)
+	d.Set("names", prefixListNames)
+	if err := d.Set("prefix_lists", tfList); err != nil {
+		return fmt.Errorf("setting prefix_lists: %w", err)
+	}
+
+	return nil
+}
+
+func flattenManagedPrefixList(apiObject *ec2.ManagedPrefixList) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AddressFamily; v != nil {
+		tfMap["address_family"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PrefixListArn; v != nil {
+		tfMap["arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PrefixListId; v != nil {
+		tfMap["id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MaxEntries; v != nil {
+		tfMap["max_entries"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.PrefixListName; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.OwnerId; v != nil {
+		tfMap["owner_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Version; v != nil {
+		tfMap["version"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
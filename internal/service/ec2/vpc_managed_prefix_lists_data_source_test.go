@@ -0,0 +1,158 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccVPCManagedPrefixListsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ec2_managed_prefix_lists.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckManagedPrefixList(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListsDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "ids.#", "0"),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "names.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "prefix_lists.0.entries.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "prefix_lists.0.owner_id", "AWS"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCManagedPrefixListsDataSource_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_managed_prefix_lists.test"
+	resourceName := "aws_ec2_managed_prefix_list.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckManagedPrefixList(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListsDataSourceConfig_tags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.0", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "prefix_lists.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "prefix_lists.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "prefix_lists.0.entries.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCManagedPrefixListsDataSource_includeEntries(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_managed_prefix_lists.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckManagedPrefixList(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListsDataSourceConfig_includeEntries(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "prefix_lists.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "prefix_lists.*.entries.*", map[string]string{
+						"cidr":        "10.0.0.0/16",
+						"description": "entry1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "prefix_lists.*.entries.*", map[string]string{
+						"cidr":        "10.1.0.0/16",
+						"description": "entry2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccVPCManagedPrefixListsDataSourceConfig_basic = `
+data "aws_ec2_managed_prefix_lists" "test" {
+  filter {
+    name   = "owner-id"
+    values = ["AWS"]
+  }
+}
+`
+
+func testAccVPCManagedPrefixListsDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test1" {
+  address_family = "IPv4"
+  max_entries    = 1
+  name           = "%[1]s-1"
+
+  entry {
+    cidr        = "10.0.0.0/16"
+    description = "entry1"
+  }
+
+  tags = {
+    Name = %[1]q
+    Key  = "test1"
+  }
+}
+
+resource "aws_ec2_managed_prefix_list" "test2" {
+  address_family = "IPv4"
+  max_entries    = 1
+  name           = "%[1]s-2"
+
+  entry {
+    cidr        = "10.1.0.0/16"
+    description = "entry2"
+  }
+
+  tags = {
+    Name = %[1]q
+    Key  = "test2"
+  }
+}
+`, rName)
+}
+
+func testAccVPCManagedPrefixListsDataSourceConfig_tags(rName string) string {
+	return acctest.ConfigCompose(testAccVPCManagedPrefixListsDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_ec2_managed_prefix_lists" "test" {
+  tags = {
+    Name = %[1]q
+    Key  = "test1"
+  }
+
+  depends_on = [aws_ec2_managed_prefix_list.test1, aws_ec2_managed_prefix_list.test2]
+}
+`, rName))
+}
+
+func testAccVPCManagedPrefixListsDataSourceConfig_includeEntries(rName string) string {
+	return acctest.ConfigCompose(testAccVPCManagedPrefixListsDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_ec2_managed_prefix_lists" "test" {
+  include_entries = true
+
+  filter {
+    name   = "tag:Name"
+    values = [%[1]q]
+  }
+
+  depends_on = [aws_ec2_managed_prefix_list.test1, aws_ec2_managed_prefix_list.test2]
+}
+`, rName))
+}
@@ -0,0 +1,133 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceNetworkInterfacePermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkInterfacePermissionCreate,
+		Read:   resourceNetworkInterfacePermissionRead,
+		Delete: resourceNetworkInterfacePermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"permission": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.InterfacePermissionType_Values(), false),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkInterfacePermissionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	networkInterfaceID := d.Get("network_interface_id").(string)
+	input := &ec2.CreateNetworkInterfacePermissionInput{
+		AwsAccountId:       aws.String(d.Get("aws_account_id").(string)),
+		NetworkInterfaceId: aws.String(networkInterfaceID),
+		Permission:         aws.String(d.Get("permission").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Network Interface Permission: %s", input)
+	output, err := conn.CreateNetworkInterfacePermission(input)
+
+	if err != nil {
+		return fmt.Errorf("creating EC2 Network Interface (%s) Permission: %w", networkInterfaceID, err)
+	}
+
+	d.SetId(aws.StringValue(output.InterfacePermission.NetworkInterfacePermissionId))
+
+	if _, err := WaitNetworkInterfacePermissionCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("waiting for EC2 Network Interface Permission (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceNetworkInterfacePermissionRead(d, meta)
+}
+
+func resourceNetworkInterfacePermissionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(propagationTimeout, func() (interface{}, error) {
+		return FindNetworkInterfacePermissionByID(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Network Interface Permission %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Network Interface Permission (%s): %w", d.Id(), err)
+	}
+
+	permission := outputRaw.(*ec2.NetworkInterfacePermission)
+
+	d.Set("aws_account_id", permission.AwsAccountId)
+	d.Set("network_interface_id", permission.NetworkInterfaceId)
+	d.Set("permission", permission.Permission)
+	d.Set("state", permission.PermissionState.State)
+
+	return nil
+}
+
+func resourceNetworkInterfacePermissionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[INFO] Deleting EC2 Network Interface Permission: %s", d.Id())
+	_, err := conn.DeleteNetworkInterfacePermission(&ec2.DeleteNetworkInterfacePermissionInput{
+		NetworkInterfacePermissionId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidPermissionIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting EC2 Network Interface Permission (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitNetworkInterfacePermissionDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("waiting for EC2 Network Interface Permission (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
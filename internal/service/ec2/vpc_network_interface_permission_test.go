@@ -0,0 +1,185 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVPCNetworkInterfacePermission_basic(t *testing.T) {
+	var providers []*schema.Provider
+	var v ec2.NetworkInterfacePermission
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_network_interface_permission.test"
+	eniResourceName := "aws_network_interface.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckNetworkInterfacePermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInterfacePermissionConfig_basic(rName, ec2.InterfacePermissionTypeInstanceAttach),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInterfacePermissionExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "aws_account_id", "data.aws_caller_identity.peer", "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", eniResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "permission", "INSTANCE-ATTACH"),
+					resource.TestCheckResourceAttr(resourceName, "state", "granted"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkInterfacePermission_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	var v ec2.NetworkInterfacePermission
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_network_interface_permission.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckNetworkInterfacePermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInterfacePermissionConfig_basic(rName, ec2.InterfacePermissionTypeInstanceAttach),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInterfacePermissionExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceNetworkInterfacePermission(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkInterfacePermission_eipAssociate(t *testing.T) {
+	var providers []*schema.Provider
+	var v ec2.NetworkInterfacePermission
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_network_interface_permission.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckNetworkInterfacePermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInterfacePermissionConfig_basic(rName, ec2.InterfacePermissionTypeEipAssociate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInterfacePermissionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "permission", "EIP-ASSOCIATE"),
+					resource.TestCheckResourceAttr(resourceName, "state", "granted"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkInterfacePermissionExists(n string, v *ec2.NetworkInterfacePermission) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Network Interface Permission ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindNetworkInterfacePermissionByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkInterfacePermissionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_network_interface_permission" {
+			continue
+		}
+
+		_, err := tfec2.FindNetworkInterfacePermissionByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Network Interface Permission %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccVPCNetworkInterfacePermissionConfig_basic(rName, permission string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateAccountProvider(),
+		testAccENIIPV4BaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_network_interface" "test" {
+  subnet_id       = aws_subnet.test.id
+  security_groups = [aws_security_group.test.id]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_caller_identity" "peer" {
+  provider = "awsalternate"
+}
+
+resource "aws_network_interface_permission" "test" {
+  network_interface_id = aws_network_interface.test.id
+  aws_account_id       = data.aws_caller_identity.peer.account_id
+  permission           = %[2]q
+}
+`, rName, permission))
+}
@@ -0,0 +1,9 @@
+package ec2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceSecurityGroupEgressRule() *schema.Resource {
+	return resourceSecurityGroupRuleByType(securityGroupRuleTypeEgress)
+}
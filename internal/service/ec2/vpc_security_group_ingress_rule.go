@@ -0,0 +1,411 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	securityGroupRuleTypeEgress  = "egress"
+	securityGroupRuleTypeIngress = "ingress"
+)
+
+func ResourceSecurityGroupIngressRule() *schema.Resource {
+	return resourceSecurityGroupRuleByType(securityGroupRuleTypeIngress)
+}
+
+// resourceSecurityGroupRuleByType returns a resource managing a single security group rule, identified by its
+// security group rule ID, of the specified type.
+func resourceSecurityGroupRuleByType(ruleType string) *schema.Resource {
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceSecurityGroupRuleByTypeCreate(d, meta, ruleType)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceSecurityGroupRuleByTypeRead(d, meta, ruleType)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceSecurityGroupRuleByTypeUpdate(d, meta, ruleType)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceSecurityGroupRuleByTypeDelete(d, meta, ruleType)
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cidr_ipv4": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"cidr_ipv6": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validSecurityGroupRuleDescription,
+			},
+			"from_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(-1, 65535),
+			},
+			"ip_protocol": {
+				Type:      schema.TypeString,
+				Required:  true,
+				StateFunc: ProtocolStateFunc,
+			},
+			"prefix_list_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"referenced_security_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"to_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(-1, 65535),
+			},
+		},
+	}
+}
+
+func resourceSecurityGroupRuleByTypeCreate(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	securityGroupID := d.Get("security_group_id").(string)
+	ipPermission := expandIPPermissionForSecurityGroupRule(d)
+
+	var tagSpecifications []*ec2.TagSpecification
+
+	if len(tags) > 0 {
+		tagSpecifications = tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeSecurityGroupRule)
+	}
+
+	var rules []*ec2.SecurityGroupRule
+	var err error
+
+	switch ruleType {
+	case securityGroupRuleTypeIngress:
+		input := &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:           aws.String(securityGroupID),
+			IpPermissions:     []*ec2.IpPermission{ipPermission},
+			TagSpecifications: tagSpecifications,
+		}
+
+		log.Printf("[DEBUG] Creating VPC Security Group Ingress Rule: %s", input)
+		var output *ec2.AuthorizeSecurityGroupIngressOutput
+		output, err = conn.AuthorizeSecurityGroupIngress(input)
+
+		if err == nil {
+			rules = output.SecurityGroupRules
+		}
+	case securityGroupRuleTypeEgress:
+		input := &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:           aws.String(securityGroupID),
+			IpPermissions:     []*ec2.IpPermission{ipPermission},
+			TagSpecifications: tagSpecifications,
+		}
+
+		log.Printf("[DEBUG] Creating VPC Security Group Egress Rule: %s", input)
+		var output *ec2.AuthorizeSecurityGroupEgressOutput
+		output, err = conn.AuthorizeSecurityGroupEgress(input)
+
+		if err == nil {
+			rules = output.SecurityGroupRules
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("error authorizing VPC Security Group (%s) %s rule: %w", securityGroupID, ruleType, err)
+	}
+
+	if len(rules) != 1 || rules[0] == nil {
+		return fmt.Errorf("error authorizing VPC Security Group (%s) %s rule: expected 1 rule, got %d", securityGroupID, ruleType, len(rules))
+	}
+
+	d.SetId(aws.StringValue(rules[0].SecurityGroupRuleId))
+
+	return resourceSecurityGroupRuleByTypeRead(d, meta, ruleType)
+}
+
+func resourceSecurityGroupRuleByTypeRead(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(propagationTimeout, func() (interface{}, error) {
+		return FindSecurityGroupRuleByID(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Security Group %s Rule %s not found, removing from state", ruleType, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading VPC Security Group %s Rule (%s): %w", ruleType, d.Id(), err)
+	}
+
+	rule := outputRaw.(*ec2.SecurityGroupRule)
+
+	if isEgress := aws.BoolValue(rule.IsEgress); isEgress != (ruleType == securityGroupRuleTypeEgress) {
+		return fmt.Errorf("VPC Security Group Rule (%s) is not an %s rule", d.Id(), ruleType)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: aws.StringValue(rule.GroupOwnerId),
+		Resource:  fmt.Sprintf("security-group-rule/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("cidr_ipv4", rule.CidrIpv4)
+	d.Set("cidr_ipv6", rule.CidrIpv6)
+	d.Set("description", rule.Description)
+	d.Set("ip_protocol", rule.IpProtocol)
+	d.Set("prefix_list_id", rule.PrefixListId)
+	d.Set("referenced_security_group_id", flattenReferencedSecurityGroup(rule.ReferencedGroupInfo, aws.StringValue(rule.GroupOwnerId)))
+	d.Set("security_group_id", rule.GroupId)
+	d.Set("security_group_rule_id", rule.SecurityGroupRuleId)
+
+	// Ports are not applicable to rules for all protocols.
+	if ProtocolForValue(aws.StringValue(rule.IpProtocol)) == "-1" {
+		d.Set("from_port", nil)
+		d.Set("to_port", nil)
+	} else {
+		d.Set("from_port", rule.FromPort)
+		d.Set("to_port", rule.ToPort)
+	}
+
+	tags := KeyValueTags(rule.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceSecurityGroupRuleByTypeUpdate(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ec2.ModifySecurityGroupRulesInput{
+			GroupId: aws.String(d.Get("security_group_id").(string)),
+			SecurityGroupRules: []*ec2.SecurityGroupRuleUpdate{{
+				SecurityGroupRule:   expandSecurityGroupRuleRequest(d),
+				SecurityGroupRuleId: aws.String(d.Id()),
+			}},
+		}
+
+		log.Printf("[DEBUG] Updating VPC Security Group %s Rule: %s", ruleType, input)
+		_, err := conn.ModifySecurityGroupRules(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating VPC Security Group %s Rule (%s): %w", ruleType, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating VPC Security Group %s Rule (%s) tags: %w", ruleType, d.Id(), err)
+		}
+	}
+
+	return resourceSecurityGroupRuleByTypeRead(d, meta, ruleType)
+}
+
+func resourceSecurityGroupRuleByTypeDelete(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	securityGroupID := d.Get("security_group_id").(string)
+	ruleIDs := aws.StringSlice([]string{d.Id()})
+
+	log.Printf("[INFO] Deleting VPC Security Group %s Rule: %s", ruleType, d.Id())
+	var err error
+
+	switch ruleType {
+	case securityGroupRuleTypeIngress:
+		_, err = conn.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: ruleIDs,
+		})
+	case securityGroupRuleTypeEgress:
+		_, err = conn.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: ruleIDs,
+		})
+	}
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidSecurityGroupRuleIdNotFound, errCodeInvalidGroupNotFound, errCodeInvalidSecurityGroupIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting VPC Security Group %s Rule (%s): %w", ruleType, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIPPermissionForSecurityGroupRule(d *schema.ResourceData) *ec2.IpPermission {
+	apiObject := &ec2.IpPermission{
+		IpProtocol: aws.String(ProtocolForValue(d.Get("ip_protocol").(string))),
+	}
+
+	if aws.StringValue(apiObject.IpProtocol) != "-1" {
+		apiObject.FromPort = aws.Int64(int64(d.Get("from_port").(int)))
+		apiObject.ToPort = aws.Int64(int64(d.Get("to_port").(int)))
+	}
+
+	var description *string
+
+	if v, ok := d.GetOk("description"); ok {
+		description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv4"); ok {
+		apiObject.IpRanges = []*ec2.IpRange{{
+			CidrIp:      aws.String(v.(string)),
+			Description: description,
+		}}
+	}
+
+	if v, ok := d.GetOk("cidr_ipv6"); ok {
+		apiObject.Ipv6Ranges = []*ec2.Ipv6Range{{
+			CidrIpv6:    aws.String(v.(string)),
+			Description: description,
+		}}
+	}
+
+	if v, ok := d.GetOk("prefix_list_id"); ok {
+		apiObject.PrefixListIds = []*ec2.PrefixListId{{
+			Description:  description,
+			PrefixListId: aws.String(v.(string)),
+		}}
+	}
+
+	if v, ok := d.GetOk("referenced_security_group_id"); ok {
+		pair := &ec2.UserIdGroupPair{
+			Description: description,
+		}
+
+		// Security groups in other accounts are referenced as "account-id/group-id".
+		if parts := strings.Split(v.(string), "/"); len(parts) == 2 {
+			pair.UserId = aws.String(parts[0])
+			pair.GroupId = aws.String(parts[1])
+		} else {
+			pair.GroupId = aws.String(v.(string))
+		}
+
+		apiObject.UserIdGroupPairs = []*ec2.UserIdGroupPair{pair}
+	}
+
+	return apiObject
+}
+
+func expandSecurityGroupRuleRequest(d *schema.ResourceData) *ec2.SecurityGroupRuleRequest {
+	apiObject := &ec2.SecurityGroupRuleRequest{
+		IpProtocol: aws.String(ProtocolForValue(d.Get("ip_protocol").(string))),
+	}
+
+	if aws.StringValue(apiObject.IpProtocol) != "-1" {
+		apiObject.FromPort = aws.Int64(int64(d.Get("from_port").(int)))
+		apiObject.ToPort = aws.Int64(int64(d.Get("to_port").(int)))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv4"); ok {
+		apiObject.CidrIpv4 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv6"); ok {
+		apiObject.CidrIpv6 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		apiObject.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("prefix_list_id"); ok {
+		apiObject.PrefixListId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("referenced_security_group_id"); ok {
+		v := v.(string)
+
+		if parts := strings.Split(v, "/"); len(parts) == 2 {
+			v = parts[1]
+		}
+
+		apiObject.ReferencedGroupId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+// flattenReferencedSecurityGroup returns the ID of a referenced security group,
+// prefixed by its owner's account ID if that is not the specified account ID.
+func flattenReferencedSecurityGroup(apiObject *ec2.ReferencedSecurityGroup, accountID string) *string {
+	if apiObject == nil {
+		return nil
+	}
+
+	if v := aws.StringValue(apiObject.UserId); v == "" || v == accountID {
+		return apiObject.GroupId
+	}
+
+	// Reference to a security group in a different account.
+	return aws.String(strings.Join([]string{aws.StringValue(apiObject.UserId), aws.StringValue(apiObject.GroupId)}, "/"))
+}
@@ -0,0 +1,125 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceSecurityGroupRule() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSecurityGroupRuleRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cidr_ipv4": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cidr_ipv6": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filter": DataSourceFiltersSchema(),
+			"from_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ip_protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_egress": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"prefix_list_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"referenced_security_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_rule_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"to_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeSecurityGroupRulesInput{
+		Filters: BuildFiltersDataSource(d.Get("filter").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("security_group_rule_id"); ok {
+		input.SecurityGroupRuleIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	rule, err := FindSecurityGroupRule(conn, input)
+
+	if err != nil {
+		return tfresource.SingularDataSourceFindError("VPC Security Group Rule", err)
+	}
+
+	d.SetId(aws.StringValue(rule.SecurityGroupRuleId))
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: aws.StringValue(rule.GroupOwnerId),
+		Resource:  fmt.Sprintf("security-group-rule/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("cidr_ipv4", rule.CidrIpv4)
+	d.Set("cidr_ipv6", rule.CidrIpv6)
+	d.Set("description", rule.Description)
+	d.Set("from_port", rule.FromPort)
+	d.Set("ip_protocol", rule.IpProtocol)
+	d.Set("is_egress", rule.IsEgress)
+	d.Set("prefix_list_id", rule.PrefixListId)
+	d.Set("referenced_security_group_id", flattenReferencedSecurityGroup(rule.ReferencedGroupInfo, aws.StringValue(rule.GroupOwnerId)))
+	d.Set("security_group_id", rule.GroupId)
+	d.Set("security_group_rule_id", rule.SecurityGroupRuleId)
+	d.Set("to_port", rule.ToPort)
+
+	if err := d.Set("tags", KeyValueTags(rule.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
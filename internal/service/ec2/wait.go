@@ -2120,6 +2120,44 @@ func WaitNetworkInterfaceDetached(conn *ec2.EC2, id string, timeout time.Duratio
 	return nil, err
 }
 
+func WaitNetworkInterfacePermissionCreated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.NetworkInterfacePermission, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.NetworkInterfacePermissionStateCodePending},
+		Target:  []string{ec2.NetworkInterfacePermissionStateCodeGranted},
+		Timeout: timeout,
+		Refresh: StatusNetworkInterfacePermissionState(conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.NetworkInterfacePermission); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.PermissionState.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitNetworkInterfacePermissionDeleted(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.NetworkInterfacePermission, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.NetworkInterfacePermissionStateCodeGranted, ec2.NetworkInterfacePermissionStateCodeRevoking},
+		Target:  []string{},
+		Timeout: timeout,
+		Refresh: StatusNetworkInterfacePermissionState(conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.NetworkInterfacePermission); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.PermissionState.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	PlacementGroupCreatedTimeout = 5 * time.Minute
 	PlacementGroupDeletedTimeout = 5 * time.Minute
@@ -2415,3 +2453,43 @@ func waitEBSSnapshotTierArchive(conn *ec2.EC2, id string, timeout time.Duration)
 
 	return nil, err
 }
+
+func WaitFastSnapshotRestoreCreated(conn *ec2.EC2, availabilityZone, snapshotID string, timeout time.Duration) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.FastSnapshotRestoreStateCodeEnabling, ec2.FastSnapshotRestoreStateCodeOptimizing},
+		Target:  []string{ec2.FastSnapshotRestoreStateCodeEnabled},
+		Refresh: StatusFastSnapshotRestore(conn, availabilityZone, snapshotID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.DescribeFastSnapshotRestoreSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitFastSnapshotRestoreDeleted(conn *ec2.EC2, availabilityZone, snapshotID string, timeout time.Duration) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.FastSnapshotRestoreStateCodeDisabling, ec2.FastSnapshotRestoreStateCodeEnabled, ec2.FastSnapshotRestoreStateCodeEnabling, ec2.FastSnapshotRestoreStateCodeOptimizing},
+		Target:  []string{},
+		Refresh: StatusFastSnapshotRestore(conn, availabilityZone, snapshotID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.DescribeFastSnapshotRestoreSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
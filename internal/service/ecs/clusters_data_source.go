@@ -0,0 +1,38 @@
+package ecs
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceClusters() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceClustersRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceClustersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	output, err := FindClusterARNs(conn, &ecs.ListClustersInput{})
+
+	if err != nil {
+		return fmt.Errorf("reading ECS Clusters: %w", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("cluster_arns", output)
+
+	return nil
+}
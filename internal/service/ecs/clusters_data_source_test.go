@@ -0,0 +1,46 @@
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECSClustersDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ecs_clusters.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClustersDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "cluster_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "cluster_arns.*", "aws_ecs_cluster.test.0", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "cluster_arns.*", "aws_ecs_cluster.test.1", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClustersDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+}
+
+data "aws_ecs_clusters" "test" {
+  depends_on = [aws_ecs_cluster.test]
+}
+`, rName)
+}
@@ -200,3 +200,116 @@ func FindService(ctx context.Context, conn *ecs.ECS, input *ecs.DescribeServices
 
 	return output.Services[0], nil
 }
+
+func FindClusterARNs(conn *ecs.ECS, input *ecs.ListClustersInput) ([]string, error) {
+	var output []string
+
+	err := conn.ListClustersPages(input, func(page *ecs.ListClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ClusterArns {
+			if v != nil {
+				output = append(output, aws.StringValue(v))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindServiceARNs(conn *ecs.ECS, input *ecs.ListServicesInput) ([]string, error) {
+	var output []string
+
+	err := conn.ListServicesPages(input, func(page *ecs.ListServicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ServiceArns {
+			if v != nil {
+				output = append(output, aws.StringValue(v))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindTaskDefinitionARNs(conn *ecs.ECS, input *ecs.ListTaskDefinitionsInput) ([]string, error) {
+	var output []string
+
+	err := conn.ListTaskDefinitionsPages(input, func(page *ecs.ListTaskDefinitionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TaskDefinitionArns {
+			if v != nil {
+				output = append(output, aws.StringValue(v))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindTasks(ctx context.Context, conn *ecs.ECS, input *ecs.DescribeTasksInput) ([]*ecs.Task, error) {
+	output, err := conn.DescribeTasksWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Newly run tasks may not yet be visible. DescribeTasks then returns a Failure with Reason = "MISSING".
+	for _, v := range output.Failures {
+		if aws.StringValue(v.Reason) == "MISSING" {
+			return nil, &resource.NotFoundError{
+				LastRequest: input,
+			}
+		}
+	}
+
+	if len(output.Tasks) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Tasks, nil
+}
@@ -0,0 +1,67 @@
+package ecs
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceServices() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceServicesRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"launch_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ecs.LaunchType_Values(), false),
+			},
+			"scheduling_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ecs.SchedulingStrategy_Values(), false),
+			},
+			"service_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceServicesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	clusterARN := d.Get("cluster_arn").(string)
+	input := &ecs.ListServicesInput{
+		Cluster: aws.String(clusterARN),
+	}
+
+	if v, ok := d.GetOk("launch_type"); ok {
+		input.LaunchType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("scheduling_strategy"); ok {
+		input.SchedulingStrategy = aws.String(v.(string))
+	}
+
+	output, err := FindServiceARNs(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("reading ECS Cluster (%s) Services: %w", clusterARN, err)
+	}
+
+	d.SetId(clusterARN)
+	d.Set("service_arns", output)
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECSServicesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ecs_services.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicesDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "aws_ecs_cluster.test", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "service_arns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "service_arns.*", "aws_ecs_service.replica", "id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "service_arns.*", "aws_ecs_service.daemon", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSServicesDataSource_schedulingStrategy(t *testing.T) {
+	dataSourceName := "data.aws_ecs_services.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicesDataSourceConfig_schedulingStrategy(rName, ecs.SchedulingStrategyDaemon),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "service_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_arns.0", "aws_ecs_service.daemon", "id"),
+				),
+			},
+			{
+				Config: testAccServicesDataSourceConfig_schedulingStrategy(rName, ecs.SchedulingStrategyReplica),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "service_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_arns.0", "aws_ecs_service.replica", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSServicesDataSource_launchType(t *testing.T) {
+	dataSourceName := "data.aws_ecs_services.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicesDataSourceConfig_launchType(rName, ecs.LaunchTypeEc2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "service_arns.#", "2"),
+				),
+			},
+			{
+				Config: testAccServicesDataSourceConfig_launchType(rName, ecs.LaunchTypeFargate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "service_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServicesDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 128,
+    "memoryReservation": 64,
+    "name": "mongodb"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "replica" {
+  name            = "%[1]s-replica"
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = 1
+}
+
+resource "aws_ecs_service" "daemon" {
+  name                = "%[1]s-daemon"
+  cluster             = aws_ecs_cluster.test.id
+  task_definition     = aws_ecs_task_definition.test.arn
+  scheduling_strategy = "DAEMON"
+}
+`, rName)
+}
+
+func testAccServicesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccServicesDataSourceConfig_base(rName), `
+data "aws_ecs_services" "test" {
+  cluster_arn = aws_ecs_cluster.test.arn
+
+  depends_on = [aws_ecs_service.replica, aws_ecs_service.daemon]
+}
+`)
+}
+
+func testAccServicesDataSourceConfig_schedulingStrategy(rName, schedulingStrategy string) string {
+	return acctest.ConfigCompose(testAccServicesDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_ecs_services" "test" {
+  cluster_arn         = aws_ecs_cluster.test.arn
+  scheduling_strategy = %[1]q
+
+  depends_on = [aws_ecs_service.replica, aws_ecs_service.daemon]
+}
+`, schedulingStrategy))
+}
+
+func testAccServicesDataSourceConfig_launchType(rName, launchType string) string {
+	return acctest.ConfigCompose(testAccServicesDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_ecs_services" "test" {
+  cluster_arn = aws_ecs_cluster.test.arn
+  launch_type = %[1]q
+
+  depends_on = [aws_ecs_service.replica, aws_ecs_service.daemon]
+}
+`, launchType))
+}
@@ -20,6 +20,15 @@ const (
 	clusterStatusError = "ERROR"
 	clusterStatusNone  = "NONE"
 
+	taskStatusActivating     = "ACTIVATING"
+	taskStatusDeactivating   = "DEACTIVATING"
+	taskStatusDeprovisioning = "DEPROVISIONING"
+	taskStatusPending        = "PENDING"
+	taskStatusProvisioning   = "PROVISIONING"
+	taskStatusRunning        = "RUNNING"
+	taskStatusStopped        = "STOPPED"
+	taskStatusStopping       = "STOPPING"
+
 	taskSetStatusActive   = "ACTIVE"
 	taskSetStatusDraining = "DRAINING"
 	taskSetStatusPrimary  = "PRIMARY"
@@ -155,3 +164,32 @@ func statusTaskSet(conn *ecs.ECS, taskSetID, service, cluster string) resource.S
 		return output.TaskSets[0], aws.StringValue(output.TaskSets[0].Status), nil
 	}
 }
+
+// statusTasks returns the last status of the first of the specified tasks that has not stopped,
+// or "STOPPED" once all of the tasks have stopped.
+func statusTasks(ctx context.Context, conn *ecs.ECS, cluster string, taskARNs []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ecs.DescribeTasksInput{
+			Cluster: aws.String(cluster),
+			Tasks:   aws.StringSlice(taskARNs),
+		}
+
+		output, err := FindTasks(ctx, conn, input)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, v := range output {
+			if status := aws.StringValue(v.LastStatus); status != taskStatusStopped {
+				return output, status, nil
+			}
+		}
+
+		return output, taskStatusStopped, nil
+	}
+}
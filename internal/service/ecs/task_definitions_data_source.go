@@ -0,0 +1,107 @@
+package ecs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceTaskDefinitions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTaskDefinitionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"families": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"family_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sort": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ecs.SortOrderAsc,
+				ValidateFunc: validation.StringInSlice(ecs.SortOrder_Values(), false),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ecs.TaskDefinitionStatusActive,
+				ValidateFunc: validation.StringInSlice(ecs.TaskDefinitionStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceTaskDefinitionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	input := &ecs.ListTaskDefinitionsInput{
+		Sort:   aws.String(d.Get("sort").(string)),
+		Status: aws.String(d.Get("status").(string)),
+	}
+
+	if v, ok := d.GetOk("family_prefix"); ok {
+		input.FamilyPrefix = aws.String(v.(string))
+	}
+
+	output, err := FindTaskDefinitionARNs(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("reading ECS Task Definitions: %w", err)
+	}
+
+	var families []string
+	seen := map[string]bool{}
+
+	for _, v := range output {
+		family, err := taskDefinitionFamilyFromARN(v)
+
+		if err != nil {
+			return err
+		}
+
+		if !seen[family] {
+			seen[family] = true
+			families = append(families, family)
+		}
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", output)
+	d.Set("families", families)
+
+	return nil
+}
+
+// taskDefinitionFamilyFromARN returns the family of a task definition ARN of the form
+// arn:PARTITION:ecs:REGION:ACCOUNTID:task-definition/FAMILY:REVISION.
+func taskDefinitionFamilyFromARN(s string) (string, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing ECS Task Definition ARN (%s): %w", s, err)
+	}
+
+	family := strings.TrimPrefix(v.Resource, "task-definition/")
+
+	if i := strings.LastIndex(family, ":"); i > 0 {
+		family = family[:i]
+	}
+
+	return family, nil
+}
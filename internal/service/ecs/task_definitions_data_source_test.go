@@ -0,0 +1,113 @@
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECSTaskDefinitionsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ecs_task_definitions.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionsDataSourceConfig_basic(rName, ecs.SortOrderAsc),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", "aws_ecs_task_definition.test1", "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.1", "aws_ecs_task_definition.test2", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "families.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "families.0", rName+"-1"),
+					resource.TestCheckResourceAttr(dataSourceName, "families.1", rName+"-2"),
+				),
+			},
+			{
+				Config: testAccTaskDefinitionsDataSourceConfig_basic(rName, ecs.SortOrderDesc),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", "aws_ecs_task_definition.test2", "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.1", "aws_ecs_task_definition.test1", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinitionsDataSource_status(t *testing.T) {
+	dataSourceName := "data.aws_ecs_task_definitions.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionsDataSourceConfig_status(rName, ecs.TaskDefinitionStatusInactive),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "families.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTaskDefinitionsDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test1" {
+  family = "%[1]s-1"
+
+  container_definitions = jsonencode([{
+    name      = "test"
+    image     = "busybox:latest"
+    cpu       = 10
+    memory    = 128
+    essential = true
+  }])
+}
+
+resource "aws_ecs_task_definition" "test2" {
+  family = "%[1]s-2"
+
+  container_definitions = jsonencode([{
+    name      = "test"
+    image     = "busybox:latest"
+    cpu       = 10
+    memory    = 128
+    essential = true
+  }])
+}
+`, rName)
+}
+
+func testAccTaskDefinitionsDataSourceConfig_basic(rName, sort string) string {
+	return acctest.ConfigCompose(testAccTaskDefinitionsDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_ecs_task_definitions" "test" {
+  family_prefix = %[1]q
+  sort          = %[2]q
+
+  depends_on = [aws_ecs_task_definition.test1, aws_ecs_task_definition.test2]
+}
+`, rName, sort))
+}
+
+func testAccTaskDefinitionsDataSourceConfig_status(rName, status string) string {
+	return acctest.ConfigCompose(testAccTaskDefinitionsDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_ecs_task_definitions" "test" {
+  family_prefix = %[1]q
+  status        = %[2]q
+
+  depends_on = [aws_ecs_task_definition.test1, aws_ecs_task_definition.test2]
+}
+`, rName, status))
+}
@@ -0,0 +1,620 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceTaskExecution() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTaskExecutionRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"capacity_provider_strategy": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"launch_type"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100000),
+						},
+						"capacity_provider": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+					},
+				},
+			},
+			"cluster": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"desired_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"enable_ecs_managed_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"enable_execute_command": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"group": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"launch_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"capacity_provider_strategy"},
+				ValidateFunc:  validation.StringInSlice(ecs.LaunchType_Values(), false),
+			},
+			"network_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assign_public_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"security_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"overrides": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_overrides": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"command": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"cpu": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"environment": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"memory": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"memory_reservation": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"cpu": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"execution_role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"memory": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"task_role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"platform_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"propagate_tags": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ecs.PropagateTags_Values(), false),
+			},
+			"reference_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"started_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": tftags.TagsSchema(),
+			"task_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"task_definition": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tasks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"containers": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exit_code": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"last_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"reason": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"last_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stop_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stopped_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"task_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTaskExecutionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ECSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	cluster := d.Get("cluster").(string)
+	taskDefinition := d.Get("task_definition").(string)
+	input := &ecs.RunTaskInput{
+		Cluster:        aws.String(cluster),
+		Count:          aws.Int64(int64(d.Get("desired_count").(int))),
+		TaskDefinition: aws.String(taskDefinition),
+	}
+
+	if v, ok := d.GetOk("capacity_provider_strategy"); ok && v.(*schema.Set).Len() > 0 {
+		input.CapacityProviderStrategy = expandCapacityProviderStrategy(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("enable_ecs_managed_tags"); ok {
+		input.EnableECSManagedTags = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("enable_execute_command"); ok {
+		input.EnableExecuteCommand = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("group"); ok {
+		input.Group = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("launch_type"); ok {
+		input.LaunchType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("network_configuration"); ok {
+		input.NetworkConfiguration = expandNetworkConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("overrides"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Overrides = expandTaskOverride(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("platform_version"); ok {
+		input.PlatformVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("propagate_tags"); ok {
+		input.PropagateTags = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("reference_id"); ok {
+		input.ReferenceId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("started_by"); ok {
+		input.StartedBy = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Running ECS Task: %s", input)
+	output, err := conn.RunTaskWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("running ECS Task (%s) in Cluster (%s): %s", taskDefinition, cluster, err)
+	}
+
+	var errs *multierror.Error
+
+	for _, v := range output.Failures {
+		errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(v.Arn), aws.StringValue(v.Reason)))
+	}
+
+	if len(output.Tasks) == 0 {
+		errs = multierror.Append(errs, fmt.Errorf("no tasks started"))
+
+		return diag.Errorf("running ECS Task (%s) in Cluster (%s): %s", taskDefinition, cluster, errs)
+	}
+
+	var taskARNs []string
+
+	for _, v := range output.Tasks {
+		taskARNs = append(taskARNs, aws.StringValue(v.TaskArn))
+	}
+
+	d.SetId(strings.Join(taskARNs, ","))
+	d.Set("task_arns", taskARNs)
+
+	if err := errs.ErrorOrNil(); err != nil {
+		return diag.Errorf("running ECS Task (%s) in Cluster (%s): %s", taskDefinition, cluster, err)
+	}
+
+	tasks, err := waitTasksStopped(ctx, conn, cluster, taskARNs, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return diag.Errorf("waiting for ECS Tasks (%s) to stop: %s", d.Id(), err)
+	}
+
+	if err := d.Set("tasks", flattenTaskExecutionTasks(tasks)); err != nil {
+		return diag.Errorf("setting tasks: %s", err)
+	}
+
+	// The exit codes of non-essential containers, which are stopped once the essential containers exit, are ignored.
+	essential, err := findTaskDefinitionEssentialContainers(ctx, conn, aws.StringValue(tasks[0].TaskDefinitionArn))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, task := range tasks {
+		for _, container := range task.Containers {
+			name := aws.StringValue(container.Name)
+
+			if !essential[name] {
+				continue
+			}
+
+			if container.ExitCode == nil {
+				errs = multierror.Append(errs, fmt.Errorf("ECS Task (%s) container (%s) did not exit: %s", aws.StringValue(task.TaskArn), name, aws.StringValue(task.StoppedReason)))
+				continue
+			}
+
+			if exitCode := aws.Int64Value(container.ExitCode); exitCode != 0 {
+				errs = multierror.Append(errs, fmt.Errorf("ECS Task (%s) container (%s) exited with code %d: %s", aws.StringValue(task.TaskArn), name, exitCode, aws.StringValue(task.StoppedReason)))
+			}
+		}
+	}
+
+	if err := errs.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// findTaskDefinitionEssentialContainers returns the names of the essential containers of the specified task definition.
+func findTaskDefinitionEssentialContainers(ctx context.Context, conn *ecs.ECS, taskDefinition string) (map[string]bool, error) {
+	output, err := conn.DescribeTaskDefinitionWithContext(ctx, &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(taskDefinition),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading ECS Task Definition (%s): %w", taskDefinition, err)
+	}
+
+	if output == nil || output.TaskDefinition == nil {
+		return nil, fmt.Errorf("reading ECS Task Definition (%s): empty result", taskDefinition)
+	}
+
+	essential := map[string]bool{}
+
+	for _, v := range output.TaskDefinition.ContainerDefinitions {
+		// Containers are essential unless explicitly marked otherwise.
+		essential[aws.StringValue(v.Name)] = v.Essential == nil || aws.BoolValue(v.Essential)
+	}
+
+	return essential, nil
+}
+
+func expandTaskOverride(tfMap map[string]interface{}) *ecs.TaskOverride {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecs.TaskOverride{}
+
+	if v, ok := tfMap["container_overrides"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ContainerOverrides = expandContainerOverrides(v)
+	}
+
+	if v, ok := tfMap["cpu"].(string); ok && v != "" {
+		apiObject.Cpu = aws.String(v)
+	}
+
+	if v, ok := tfMap["execution_role_arn"].(string); ok && v != "" {
+		apiObject.ExecutionRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["memory"].(string); ok && v != "" {
+		apiObject.Memory = aws.String(v)
+	}
+
+	if v, ok := tfMap["task_role_arn"].(string); ok && v != "" {
+		apiObject.TaskRoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandContainerOverride(tfMap map[string]interface{}) *ecs.ContainerOverride {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecs.ContainerOverride{}
+
+	if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Command = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["cpu"].(int); ok && v != 0 {
+		apiObject.Cpu = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["environment"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Environment = expandKeyValuePairs(v.List())
+	}
+
+	if v, ok := tfMap["memory"].(int); ok && v != 0 {
+		apiObject.Memory = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+		apiObject.MemoryReservation = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandContainerOverrides(tfList []interface{}) []*ecs.ContainerOverride {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ecs.ContainerOverride
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandContainerOverride(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandKeyValuePairs(tfList []interface{}) []*ecs.KeyValuePair {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ecs.KeyValuePair
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.KeyValuePair{
+			Name:  aws.String(tfMap["key"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenTaskExecutionContainer(apiObject *ecs.Container) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ExitCode; v != nil {
+		tfMap["exit_code"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.LastStatus; v != nil {
+		tfMap["last_status"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Reason; v != nil {
+		tfMap["reason"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenTaskExecutionContainers(apiObjects []*ecs.Container) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenTaskExecutionContainer(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenTaskExecutionTask(apiObject *ecs.Task) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Containers; v != nil {
+		tfMap["containers"] = flattenTaskExecutionContainers(v)
+	}
+
+	if v := apiObject.LastStatus; v != nil {
+		tfMap["last_status"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.StopCode; v != nil {
+		tfMap["stop_code"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.StoppedReason; v != nil {
+		tfMap["stopped_reason"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TaskArn; v != nil {
+		tfMap["task_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenTaskExecutionTasks(apiObjects []*ecs.Task) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenTaskExecutionTask(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,132 @@
+package ecs_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECSTaskExecutionDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ecs_task_execution.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskExecutionDataSourceConfig_basic(rName, "exit 0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "task_arns.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tasks.0.task_arn", dataSourceName, "task_arns.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.last_status", "STOPPED"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.stop_code", "EssentialContainerExited"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.containers.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.containers.0.name", "test"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.containers.0.exit_code", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSTaskExecutionDataSource_nonZeroExitCode(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTaskExecutionDataSourceConfig_basic(rName, "exit 3"),
+				ExpectError: regexp.MustCompile(`container \(test\) exited with code 3`),
+			},
+		},
+	})
+}
+
+func testAccTaskExecutionDataSourceConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route" "test" {
+  route_table_id         = aws_vpc.test.main_route_table_id
+  destination_cidr_block = "0.0.0.0/0"
+  gateway_id             = aws_internet_gateway.test.id
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = jsonencode([{
+    name      = "test"
+    image     = "public.ecr.aws/docker/library/busybox:latest"
+    essential = true
+    command   = ["sh", "-c", "exit 1"]
+  }])
+}
+`, rName))
+}
+
+func testAccTaskExecutionDataSourceConfig_basic(rName, command string) string {
+	return acctest.ConfigCompose(testAccTaskExecutionDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_ecs_task_execution" "test" {
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  launch_type     = "FARGATE"
+
+  network_configuration {
+    subnets          = aws_subnet.test[*].id
+    security_groups  = [aws_security_group.test.id]
+    assign_public_ip = true
+  }
+
+  overrides {
+    container_overrides {
+      name    = "test"
+      command = ["sh", "-c", %[1]q]
+    }
+  }
+
+  depends_on = [aws_route.test]
+}
+`, command))
+}
@@ -24,6 +24,8 @@ const (
 	clusterReadTimeout      = 2 * time.Second
 	clusterUpdateTimeout    = 10 * time.Minute
 
+	taskStoppedDelay = 10 * time.Second
+
 	taskSetCreateTimeout = 10 * time.Minute
 	taskSetDeleteTimeout = 10 * time.Minute
 )
@@ -193,3 +195,30 @@ func waitTaskSetDeleted(conn *ecs.ECS, taskSetID, service, cluster string) error
 
 	return err
 }
+
+// waitTasksStopped waits for all of the specified ECS Tasks to reach the status "STOPPED".
+func waitTasksStopped(ctx context.Context, conn *ecs.ECS, cluster string, taskARNs []string, timeout time.Duration) ([]*ecs.Task, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			taskStatusProvisioning,
+			taskStatusPending,
+			taskStatusActivating,
+			taskStatusRunning,
+			taskStatusDeactivating,
+			taskStatusStopping,
+			taskStatusDeprovisioning,
+		},
+		Target:  []string{taskStatusStopped},
+		Refresh: statusTasks(ctx, conn, cluster, taskARNs),
+		Timeout: timeout,
+		Delay:   taskStoppedDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.([]*ecs.Task); ok {
+		return v, err
+	}
+
+	return nil, err
+}
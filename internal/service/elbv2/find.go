@@ -92,3 +92,83 @@ func FindTargetGroupByARN(conn *elbv2.ELBV2, arn string) (*elbv2.TargetGroup, er
 
 	return nil, nil
 }
+
+func FindLoadBalancers(conn *elbv2.ELBV2, input *elbv2.DescribeLoadBalancersInput) ([]*elbv2.LoadBalancer, error) {
+	var output []*elbv2.LoadBalancer
+
+	err := conn.DescribeLoadBalancersPages(input, func(page *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LoadBalancers {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindTargetGroups(conn *elbv2.ELBV2, input *elbv2.DescribeTargetGroupsInput) ([]*elbv2.TargetGroup, error) {
+	var output []*elbv2.TargetGroup
+
+	err := conn.DescribeTargetGroupsPages(input, func(page *elbv2.DescribeTargetGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TargetGroups {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindListenerRules returns the rules matching the input.
+// DescribeRules has no paginator, so pages are requested manually.
+func FindListenerRules(conn *elbv2.ELBV2, input *elbv2.DescribeRulesInput) ([]*elbv2.Rule, error) {
+	var output []*elbv2.Rule
+
+	for {
+		page, err := conn.DescribeRules(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		for _, v := range page.Rules {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.NextMarker) == "" {
+			break
+		}
+
+		input.Marker = page.NextMarker
+	}
+
+	return output, nil
+}
@@ -951,6 +951,8 @@ func expandLbListenerActionForwardConfigTargetGroupStickinessConfig(l []interfac
 	}
 }
 
+// flattenLbListenerActions flattens listener actions.
+// d, if not nil, is used to read back the configured OIDC client secret, which the API does not return.
 func flattenLbListenerActions(d *schema.ResourceData, Actions []*elbv2.Action) []interface{} {
 	if len(Actions) == 0 {
 		return []interface{}{}
@@ -985,8 +987,10 @@ func flattenLbListenerActions(d *schema.ResourceData, Actions []*elbv2.Action) [
 			// The LB API currently provides no way to read the ClientSecret
 			// Instead we passthrough the configuration value into the state
 			var clientSecret string
-			if v, ok := d.GetOk("default_action." + strconv.Itoa(i) + ".authenticate_oidc.0.client_secret"); ok {
-				clientSecret = v.(string)
+			if d != nil {
+				if v, ok := d.GetOk("default_action." + strconv.Itoa(i) + ".authenticate_oidc.0.client_secret"); ok {
+					clientSecret = v.(string)
+				}
 			}
 
 			m["authenticate_oidc"] = flattenAuthenticateOIDCActionConfig(action.AuthenticateOidcConfig, clientSecret)
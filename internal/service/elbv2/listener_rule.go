@@ -706,61 +706,7 @@ func resourceListenerRuleRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("action", actions)
 
-	conditions := make([]interface{}, len(rule.Conditions))
-	for i, condition := range rule.Conditions {
-		conditionMap := make(map[string]interface{})
-
-		switch aws.StringValue(condition.Field) {
-		case "host-header":
-			conditionMap["host_header"] = []interface{}{
-				map[string]interface{}{
-					"values": flex.FlattenStringSet(condition.HostHeaderConfig.Values),
-				},
-			}
-
-		case "http-header":
-			conditionMap["http_header"] = []interface{}{
-				map[string]interface{}{
-					"http_header_name": aws.StringValue(condition.HttpHeaderConfig.HttpHeaderName),
-					"values":           flex.FlattenStringSet(condition.HttpHeaderConfig.Values),
-				},
-			}
-
-		case "http-request-method":
-			conditionMap["http_request_method"] = []interface{}{
-				map[string]interface{}{
-					"values": flex.FlattenStringSet(condition.HttpRequestMethodConfig.Values),
-				},
-			}
-
-		case "path-pattern":
-			conditionMap["path_pattern"] = []interface{}{
-				map[string]interface{}{
-					"values": flex.FlattenStringSet(condition.PathPatternConfig.Values),
-				},
-			}
-
-		case "query-string":
-			values := make([]interface{}, len(condition.QueryStringConfig.Values))
-			for k, value := range condition.QueryStringConfig.Values {
-				values[k] = map[string]interface{}{
-					"key":   aws.StringValue(value.Key),
-					"value": aws.StringValue(value.Value),
-				}
-			}
-			conditionMap["query_string"] = values
-
-		case "source-ip":
-			conditionMap["source_ip"] = []interface{}{
-				map[string]interface{}{
-					"values": flex.FlattenStringSet(condition.SourceIpConfig.Values),
-				},
-			}
-		}
-
-		conditions[i] = conditionMap
-	}
-	if err := d.Set("condition", conditions); err != nil {
+	if err := d.Set("condition", flattenLbListenerRuleConditions(rule.Conditions)); err != nil {
 		return fmt.Errorf("error setting condition: %w", err)
 	}
 
@@ -1094,3 +1040,62 @@ func lbListenerRuleConditions(conditions []interface{}) ([]*elbv2.RuleCondition,
 	}
 	return elbConditions, nil
 }
+
+func flattenLbListenerRuleConditions(apiObjects []*elbv2.RuleCondition) []interface{} {
+	conditions := make([]interface{}, len(apiObjects))
+	for i, condition := range apiObjects {
+		conditionMap := make(map[string]interface{})
+
+		switch aws.StringValue(condition.Field) {
+		case "host-header":
+			conditionMap["host_header"] = []interface{}{
+				map[string]interface{}{
+					"values": flex.FlattenStringSet(condition.HostHeaderConfig.Values),
+				},
+			}
+
+		case "http-header":
+			conditionMap["http_header"] = []interface{}{
+				map[string]interface{}{
+					"http_header_name": aws.StringValue(condition.HttpHeaderConfig.HttpHeaderName),
+					"values":           flex.FlattenStringSet(condition.HttpHeaderConfig.Values),
+				},
+			}
+
+		case "http-request-method":
+			conditionMap["http_request_method"] = []interface{}{
+				map[string]interface{}{
+					"values": flex.FlattenStringSet(condition.HttpRequestMethodConfig.Values),
+				},
+			}
+
+		case "path-pattern":
+			conditionMap["path_pattern"] = []interface{}{
+				map[string]interface{}{
+					"values": flex.FlattenStringSet(condition.PathPatternConfig.Values),
+				},
+			}
+
+		case "query-string":
+			values := make([]interface{}, len(condition.QueryStringConfig.Values))
+			for k, value := range condition.QueryStringConfig.Values {
+				values[k] = map[string]interface{}{
+					"key":   aws.StringValue(value.Key),
+					"value": aws.StringValue(value.Value),
+				}
+			}
+			conditionMap["query_string"] = values
+
+		case "source-ip":
+			conditionMap["source_ip"] = []interface{}{
+				map[string]interface{}{
+					"values": flex.FlattenStringSet(condition.SourceIpConfig.Values),
+				},
+			}
+		}
+
+		conditions[i] = conditionMap
+	}
+
+	return conditions
+}
@@ -0,0 +1,198 @@
+package elbv2
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceListenerRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceListenerRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"listener_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": dataSourceListenerActionsSchema(),
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"condition": dataSourceListenerRuleConditionsSchema(),
+						"is_default": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceListenerRuleConditionsSchema() *schema.Schema {
+	valuesSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"host_header": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"values": valuesSchema(),
+						},
+					},
+				},
+				"http_header": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"http_header_name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"values": valuesSchema(),
+						},
+					},
+				},
+				"http_request_method": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"values": valuesSchema(),
+						},
+					},
+				},
+				"path_pattern": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"values": valuesSchema(),
+						},
+					},
+				},
+				"query_string": {
+					Type:     schema.TypeSet,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"key": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"value": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"source_ip": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"values": valuesSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceListenerRulesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ELBV2Conn
+
+	listenerARN := d.Get("listener_arn").(string)
+	input := &elbv2.DescribeRulesInput{
+		ListenerArn: aws.String(listenerARN),
+	}
+
+	results, err := FindListenerRules(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("reading ELBv2 Listener (%s) Rules: %w", listenerARN, err)
+	}
+
+	type listenerRule struct {
+		priority int
+		rule     *elbv2.Rule
+	}
+
+	var rules []listenerRule
+
+	for _, rule := range results {
+		// The default rule is evaluated last.
+		priority := listenerRulePriorityDefault
+
+		if !aws.BoolValue(rule.IsDefault) {
+			if priority, err = strconv.Atoi(aws.StringValue(rule.Priority)); err != nil {
+				return fmt.Errorf("parsing ELBv2 Listener Rule (%s) priority (%s): %w", aws.StringValue(rule.RuleArn), aws.StringValue(rule.Priority), err)
+			}
+		}
+
+		rules = append(rules, listenerRule{priority: priority, rule: rule})
+	}
+
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].priority < rules[j].priority
+	})
+
+	tfList := make([]interface{}, 0, len(rules))
+
+	for _, v := range rules {
+		actions := v.rule.Actions
+
+		sort.Slice(actions, func(i, j int) bool {
+			return aws.Int64Value(actions[i].Order) < aws.Int64Value(actions[j].Order)
+		})
+
+		tfList = append(tfList, map[string]interface{}{
+			"action":     flattenLbListenerActions(nil, actions),
+			"arn":        aws.StringValue(v.rule.RuleArn),
+			"condition":  flattenLbListenerRuleConditions(v.rule.Conditions),
+			"is_default": aws.BoolValue(v.rule.IsDefault),
+			"priority":   v.priority,
+		})
+	}
+
+	d.SetId(listenerARN)
+
+	if err := d.Set("rules", tfList); err != nil {
+		return fmt.Errorf("setting rules: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package elbv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccELBV2ListenerRulesDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lb_listener_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, elbv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRulesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "listener_arn", "aws_lb_listener.test", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.#", "3"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.arn", "aws_lb_listener_rule.first", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.priority", "10"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.is_default", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.action.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.action.0.type", "forward"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.action.0.target_group_arn", "aws_lb_target_group.test", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.condition.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "rules.0.condition.*", map[string]string{
+						"path_pattern.#":          "1",
+						"path_pattern.0.values.#": "1",
+					}),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.1.arn", "aws_lb_listener_rule.second", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.1.priority", "20"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.1.action.0.type", "fixed-response"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.1.action.0.fixed_response.0.status_code", "403"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "rules.1.condition.*", map[string]string{
+						"host_header.#":          "1",
+						"host_header.0.values.#": "1",
+					}),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.2.is_default", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.2.priority", "99999"),
+				),
+			},
+		},
+	})
+}
+
+func testAccListenerRulesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_lb" "test" {
+  name               = %[1]q
+  internal           = true
+  load_balancer_type = "application"
+  subnets            = aws_subnet.test[*].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_lb_target_group" "test" {
+  name     = %[1]q
+  port     = 80
+  protocol = "HTTP"
+  vpc_id   = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_lb_listener" "test" {
+  load_balancer_arn = aws_lb.test.arn
+  port              = 80
+  protocol          = "HTTP"
+
+  default_action {
+    type             = "forward"
+    target_group_arn = aws_lb_target_group.test.arn
+  }
+}
+
+resource "aws_lb_listener_rule" "first" {
+  listener_arn = aws_lb_listener.test.arn
+  priority     = 10
+
+  action {
+    type             = "forward"
+    target_group_arn = aws_lb_target_group.test.arn
+  }
+
+  condition {
+    path_pattern {
+      values = ["/static/*"]
+    }
+  }
+}
+
+resource "aws_lb_listener_rule" "second" {
+  listener_arn = aws_lb_listener.test.arn
+  priority     = 20
+
+  action {
+    type = "fixed-response"
+
+    fixed_response {
+      content_type = "text/plain"
+      message_body = "Forbidden"
+      status_code  = "403"
+    }
+  }
+
+  condition {
+    host_header {
+      values = ["example.com"]
+    }
+  }
+}
+
+data "aws_lb_listener_rules" "test" {
+  listener_arn = aws_lb_listener.test.arn
+
+  depends_on = [aws_lb_listener_rule.first, aws_lb_listener_rule.second]
+}
+`, rName))
+}
@@ -0,0 +1,89 @@
+package elbv2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceLoadBalancers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLoadBalancersRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"load_balancer_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(elbv2.LoadBalancerTypeEnum_Values(), false),
+			},
+			"tags": tftags.TagsSchema(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceLoadBalancersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ELBV2Conn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	results, err := FindLoadBalancers(conn, &elbv2.DescribeLoadBalancersInput{})
+
+	if err != nil {
+		return fmt.Errorf("reading ELBv2 Load Balancers: %w", err)
+	}
+
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	loadBalancerType := d.Get("load_balancer_type").(string)
+	vpcID := d.Get("vpc_id").(string)
+
+	var arns []string
+
+	for _, loadBalancer := range results {
+		if loadBalancerType != "" && aws.StringValue(loadBalancer.Type) != loadBalancerType {
+			continue
+		}
+
+		if vpcID != "" && aws.StringValue(loadBalancer.VpcId) != vpcID {
+			continue
+		}
+
+		arn := aws.StringValue(loadBalancer.LoadBalancerArn)
+
+		if len(tagsToMatch) > 0 {
+			tags, err := ListTags(conn, arn)
+
+			if tfawserr.ErrCodeEquals(err, elbv2.ErrCodeLoadBalancerNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("listing tags for ELBv2 Load Balancer (%s): %w", arn, err)
+			}
+
+			if !tags.ContainsAll(tagsToMatch) {
+				continue
+			}
+		}
+
+		arns = append(arns, arn)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package elbv2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceTargetGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTargetGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"load_balancer_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags": tftags.TagsSchema(),
+			"target_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(elbv2.TargetTypeEnum_Values(), false),
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceTargetGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ELBV2Conn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &elbv2.DescribeTargetGroupsInput{}
+
+	if v, ok := d.GetOk("load_balancer_arn"); ok {
+		input.LoadBalancerArn = aws.String(v.(string))
+	}
+
+	results, err := FindTargetGroups(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("reading ELBv2 Target Groups: %w", err)
+	}
+
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	targetType := d.Get("target_type").(string)
+	vpcID := d.Get("vpc_id").(string)
+
+	var arns []string
+
+	for _, targetGroup := range results {
+		if targetType != "" && aws.StringValue(targetGroup.TargetType) != targetType {
+			continue
+		}
+
+		if vpcID != "" && aws.StringValue(targetGroup.VpcId) != vpcID {
+			continue
+		}
+
+		arn := aws.StringValue(targetGroup.TargetGroupArn)
+
+		if len(tagsToMatch) > 0 {
+			tags, err := ListTags(conn, arn)
+
+			if tfawserr.ErrCodeEquals(err, elbv2.ErrCodeTargetGroupNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("listing tags for ELBv2 Target Group (%s): %w", arn, err)
+			}
+
+			if !tags.ContainsAll(tagsToMatch) {
+				continue
+			}
+		}
+
+		arns = append(arns, arn)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+
+	return nil
+}
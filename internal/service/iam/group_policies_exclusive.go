@@ -0,0 +1,130 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceGroupPoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGroupPoliciesExclusiveCreate,
+		Read:   resourceGroupPoliciesExclusiveRead,
+		Update: resourceGroupPoliciesExclusiveUpdate,
+		Delete: resourceGroupPoliciesExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceGroupPoliciesExclusiveImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGroupPoliciesExclusiveCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	groupName := d.Get("group_name").(string)
+
+	if err := syncGroupPolicies(conn, groupName, d.Get("policy_names").(*schema.Set)); err != nil {
+		return fmt.Errorf("creating IAM Group Policies Exclusive (%s): %w", groupName, err)
+	}
+
+	d.SetId(groupName)
+
+	return resourceGroupPoliciesExclusiveRead(d, meta)
+}
+
+func resourceGroupPoliciesExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	policyNames, err := FindGroupPolicyNames(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Group Policies Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading IAM Group Policies Exclusive (%s): %w", d.Id(), err)
+	}
+
+	d.Set("policy_names", flex.FlattenStringSet(policyNames))
+	d.Set("group_name", d.Id())
+
+	return nil
+}
+
+func resourceGroupPoliciesExclusiveUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	if d.HasChange("policy_names") {
+		if err := syncGroupPolicies(conn, d.Id(), d.Get("policy_names").(*schema.Set)); err != nil {
+			return fmt.Errorf("updating IAM Group Policies Exclusive (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceGroupPoliciesExclusiveRead(d, meta)
+}
+
+func resourceGroupPoliciesExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// Destroying this resource only stops exclusive management of the group's inline policies.
+	log.Printf("[DEBUG] Removing IAM Group Policies Exclusive (%s) from state", d.Id())
+
+	return nil
+}
+
+func resourceGroupPoliciesExclusiveImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("group_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// syncGroupPolicies deletes any inline policies embedded in the specified group that are not in the specified set of policy names.
+func syncGroupPolicies(conn *iam.IAM, groupName string, want *schema.Set) error {
+	output, err := FindGroupPolicyNames(conn, groupName)
+
+	if err != nil {
+		return fmt.Errorf("reading IAM Group (%s) inline policies: %w", groupName, err)
+	}
+
+	have := flex.FlattenStringSet(output)
+
+	for _, v := range flex.ExpandStringSet(have.Difference(want)) {
+		policyName := aws.StringValue(v)
+		log.Printf("[DEBUG] Deleting IAM Group (%s) inline policy: %s", groupName, policyName)
+		_, err := conn.DeleteGroupPolicy(&iam.DeleteGroupPolicyInput{
+			PolicyName: aws.String(policyName),
+			GroupName:  aws.String(groupName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting IAM Group (%s) inline policy (%s): %w", groupName, policyName, err)
+		}
+	}
+
+	return nil
+}
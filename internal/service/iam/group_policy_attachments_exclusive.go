@@ -0,0 +1,139 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceGroupPolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGroupPolicyAttachmentsExclusiveCreate,
+		Read:   resourceGroupPolicyAttachmentsExclusiveRead,
+		Update: resourceGroupPolicyAttachmentsExclusiveUpdate,
+		Delete: resourceGroupPolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceGroupPolicyAttachmentsExclusiveImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGroupPolicyAttachmentsExclusiveCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	groupName := d.Get("group_name").(string)
+
+	if err := syncGroupPolicyAttachments(conn, groupName, d.Get("policy_arns").(*schema.Set)); err != nil {
+		return fmt.Errorf("creating IAM Group Policy Attachments Exclusive (%s): %w", groupName, err)
+	}
+
+	d.SetId(groupName)
+
+	return resourceGroupPolicyAttachmentsExclusiveRead(d, meta)
+}
+
+func resourceGroupPolicyAttachmentsExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	policyARNs, err := FindGroupAttachedPolicyARNs(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Group Policy Attachments Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading IAM Group Policy Attachments Exclusive (%s): %w", d.Id(), err)
+	}
+
+	d.Set("policy_arns", flex.FlattenStringSet(policyARNs))
+	d.Set("group_name", d.Id())
+
+	return nil
+}
+
+func resourceGroupPolicyAttachmentsExclusiveUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	if d.HasChange("policy_arns") {
+		if err := syncGroupPolicyAttachments(conn, d.Id(), d.Get("policy_arns").(*schema.Set)); err != nil {
+			return fmt.Errorf("updating IAM Group Policy Attachments Exclusive (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceGroupPolicyAttachmentsExclusiveRead(d, meta)
+}
+
+func resourceGroupPolicyAttachmentsExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// Destroying this resource only stops exclusive management of the group's managed policy attachments.
+	log.Printf("[DEBUG] Removing IAM Group Policy Attachments Exclusive (%s) from state", d.Id())
+
+	return nil
+}
+
+func resourceGroupPolicyAttachmentsExclusiveImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("group_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// syncGroupPolicyAttachments attaches the specified managed policies to the specified group and detaches any others.
+func syncGroupPolicyAttachments(conn *iam.IAM, groupName string, want *schema.Set) error {
+	output, err := FindGroupAttachedPolicyARNs(conn, groupName)
+
+	if err != nil {
+		return fmt.Errorf("reading IAM Group (%s) managed policy attachments: %w", groupName, err)
+	}
+
+	have := flex.FlattenStringSet(output)
+
+	for _, v := range flex.ExpandStringSet(want.Difference(have)) {
+		policyARN := aws.StringValue(v)
+		log.Printf("[DEBUG] Attaching IAM Group (%s) managed policy: %s", groupName, policyARN)
+		if err := attachPolicyToGroup(conn, groupName, policyARN); err != nil {
+			return fmt.Errorf("attaching IAM Group (%s) managed policy (%s): %w", groupName, policyARN, err)
+		}
+	}
+
+	for _, v := range flex.ExpandStringSet(have.Difference(want)) {
+		policyARN := aws.StringValue(v)
+		log.Printf("[DEBUG] Detaching IAM Group (%s) managed policy: %s", groupName, policyARN)
+		err := detachPolicyFromGroup(conn, groupName, policyARN)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("detaching IAM Group (%s) managed policy (%s): %w", groupName, policyARN, err)
+		}
+	}
+
+	return nil
+}
@@ -1,12 +1,17 @@
 package iam
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -17,12 +22,12 @@ import (
 
 func ResourceOpenIDConnectProvider() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOpenIDConnectProviderCreate,
-		Read:   resourceOpenIDConnectProviderRead,
-		Update: resourceOpenIDConnectProviderUpdate,
-		Delete: resourceOpenIDConnectProviderDelete,
+		CreateWithoutTimeout: resourceOpenIDConnectProviderCreate,
+		ReadWithoutTimeout:   resourceOpenIDConnectProviderRead,
+		UpdateWithoutTimeout: resourceOpenIDConnectProviderUpdate,
+		DeleteWithoutTimeout: resourceOpenIDConnectProviderDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -52,43 +57,64 @@ func ResourceOpenIDConnectProvider() *schema.Resource {
 					ValidateFunc: validation.StringLenBetween(40, 40),
 				},
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
+				Computed: true,
+			},
+			"thumbprint_auto_discovery": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceOpenIDConnectProviderCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
-func resourceOpenIDConnectProviderCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpenIDConnectProviderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).IAMConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
+	url := d.Get("url").(string)
 	input := &iam.CreateOpenIDConnectProviderInput{
-		Url:            aws.String(d.Get("url").(string)),
+		Url:            aws.String(url),
 		ClientIDList:   flex.ExpandStringList(d.Get("client_id_list").([]interface{})),
 		ThumbprintList: flex.ExpandStringList(d.Get("thumbprint_list").([]interface{})),
 	}
 
+	// The thumbprint could not be discovered during plan if the URL was not yet known.
+	if len(input.ThumbprintList) == 0 && d.Get("thumbprint_auto_discovery").(bool) {
+		thumbprint, err := findOpenIDConnectProviderThumbprint(ctx, cleanhttp.DefaultClient(), url)
+
+		if err != nil {
+			return diag.Errorf("discovering IAM OIDC Provider (%s) thumbprint: %s", url, err)
+		}
+
+		input.ThumbprintList = aws.StringSlice([]string{thumbprint})
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
 
-	out, err := conn.CreateOpenIDConnectProvider(input)
+	out, err := conn.CreateOpenIDConnectProviderWithContext(ctx, input)
 
 	// Some partitions (i.e., ISO) may not support tag-on-create
 	if input.Tags != nil && verify.CheckISOErrorTagsUnsupported(conn.PartitionID, err) {
 		log.Printf("[WARN] failed creating IAM OIDC Provider with tags: %s. Trying create without tags.", err)
 		input.Tags = nil
 
-		out, err = conn.CreateOpenIDConnectProvider(input)
+		out, err = conn.CreateOpenIDConnectProviderWithContext(ctx, input)
 	}
 
 	if err != nil {
-		return fmt.Errorf("error creating IAM OIDC Provider: %w", err)
+		return diag.Errorf("error creating IAM OIDC Provider: %s", err)
 	}
 
 	d.SetId(aws.StringValue(out.OpenIDConnectProviderArn))
@@ -100,18 +126,18 @@ func resourceOpenIDConnectProviderCreate(d *schema.ResourceData, meta interface{
 		// If default tags only, log and continue. Otherwise, error.
 		if v, ok := d.GetOk("tags"); (!ok || len(v.(map[string]interface{})) == 0) && verify.CheckISOErrorTagsUnsupported(conn.PartitionID, err) {
 			log.Printf("[WARN] failed adding tags after create for IAM OIDC Provider (%s): %s", d.Id(), err)
-			return resourceOpenIDConnectProviderRead(d, meta)
+			return resourceOpenIDConnectProviderRead(ctx, d, meta)
 		}
 
 		if err != nil {
-			return fmt.Errorf("failed adding tags after create for IAM OIDC Provider (%s): %w", d.Id(), err)
+			return diag.Errorf("failed adding tags after create for IAM OIDC Provider (%s): %s", d.Id(), err)
 		}
 	}
 
-	return resourceOpenIDConnectProviderRead(d, meta)
+	return resourceOpenIDConnectProviderRead(ctx, d, meta)
 }
 
-func resourceOpenIDConnectProviderRead(d *schema.ResourceData, meta interface{}) error {
+func resourceOpenIDConnectProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).IAMConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -119,14 +145,14 @@ func resourceOpenIDConnectProviderRead(d *schema.ResourceData, meta interface{})
 	input := &iam.GetOpenIDConnectProviderInput{
 		OpenIDConnectProviderArn: aws.String(d.Id()),
 	}
-	out, err := conn.GetOpenIDConnectProvider(input)
+	out, err := conn.GetOpenIDConnectProviderWithContext(ctx, input)
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
 		log.Printf("[WARN] IAM OIDC Provider (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("error reading IAM OIDC Provider (%s): %w", d.Id(), err)
+		return diag.Errorf("error reading IAM OIDC Provider (%s): %s", d.Id(), err)
 	}
 
 	d.Set("arn", d.Id())
@@ -138,17 +164,17 @@ func resourceOpenIDConnectProviderRead(d *schema.ResourceData, meta interface{})
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.Errorf("error setting tags: %s", err)
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.Errorf("error setting tags_all: %s", err)
 	}
 
 	return nil
 }
 
-func resourceOpenIDConnectProviderUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpenIDConnectProviderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).IAMConn
 
 	if d.HasChange("thumbprint_list") {
@@ -157,9 +183,9 @@ func resourceOpenIDConnectProviderUpdate(d *schema.ResourceData, meta interface{
 			ThumbprintList:           flex.ExpandStringList(d.Get("thumbprint_list").([]interface{})),
 		}
 
-		_, err := conn.UpdateOpenIDConnectProviderThumbprint(input)
+		_, err := conn.UpdateOpenIDConnectProviderThumbprintWithContext(ctx, input)
 		if err != nil {
-			return fmt.Errorf("error updating IAM OIDC Provider (%s) thumbprint: %w", d.Id(), err)
+			return diag.Errorf("error updating IAM OIDC Provider (%s) thumbprint: %s", d.Id(), err)
 		}
 	}
 
@@ -171,30 +197,64 @@ func resourceOpenIDConnectProviderUpdate(d *schema.ResourceData, meta interface{
 		// Some partitions (i.e., ISO) may not support tagging, giving error
 		if verify.CheckISOErrorTagsUnsupported(conn.PartitionID, err) {
 			log.Printf("[WARN] failed updating tags for IAM OIDC Provider (%s): %s", d.Id(), err)
-			return resourceOpenIDConnectProviderRead(d, meta)
+			return resourceOpenIDConnectProviderRead(ctx, d, meta)
 		}
 
 		if err != nil {
-			return fmt.Errorf("failed updating tags for IAM OIDC Provider (%s): %w", d.Id(), err)
+			return diag.Errorf("failed updating tags for IAM OIDC Provider (%s): %s", d.Id(), err)
 		}
 	}
 
-	return resourceOpenIDConnectProviderRead(d, meta)
+	return resourceOpenIDConnectProviderRead(ctx, d, meta)
 }
 
-func resourceOpenIDConnectProviderDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceOpenIDConnectProviderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).IAMConn
 
 	input := &iam.DeleteOpenIDConnectProviderInput{
 		OpenIDConnectProviderArn: aws.String(d.Id()),
 	}
-	_, err := conn.DeleteOpenIDConnectProvider(input)
+	_, err := conn.DeleteOpenIDConnectProviderWithContext(ctx, input)
 	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("error deleting IAM OIDC Provider (%s): %w", d.Id(), err)
+		return diag.Errorf("error deleting IAM OIDC Provider (%s): %s", d.Id(), err)
 	}
 
 	return nil
 }
+
+func resourceOpenIDConnectProviderCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	thumbprintListConfigured := !diff.GetRawConfig().GetAttr("thumbprint_list").IsNull()
+
+	if !diff.Get("thumbprint_auto_discovery").(bool) {
+		if !thumbprintListConfigured {
+			return fmt.Errorf(`one of "thumbprint_list" or "thumbprint_auto_discovery" must be configured`)
+		}
+
+		return nil
+	}
+
+	if thumbprintListConfigured {
+		return fmt.Errorf(`"thumbprint_list" cannot be configured when "thumbprint_auto_discovery" is enabled`)
+	}
+
+	if !diff.NewValueKnown("url") {
+		return diff.SetNewComputed("thumbprint_list")
+	}
+
+	// Discover the thumbprint on every plan so that a rotated issuer certificate shows as a difference.
+	url := diff.Get("url").(string)
+	thumbprint, err := findOpenIDConnectProviderThumbprint(ctx, cleanhttp.DefaultClient(), url)
+
+	if err != nil {
+		return fmt.Errorf("discovering IAM OIDC Provider (%s) thumbprint: %w", url, err)
+	}
+
+	if v := diff.Get("thumbprint_list").([]interface{}); len(v) == 1 && strings.EqualFold(v[0].(string), thumbprint) {
+		return nil
+	}
+
+	return diff.SetNew("thumbprint_list", []interface{}{thumbprint})
+}
@@ -2,6 +2,7 @@ package iam_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -129,6 +130,49 @@ func TestAccIAMOpenIDConnectProvider_disappears(t *testing.T) {
 	})
 }
 
+func TestAccIAMOpenIDConnectProvider_thumbprintAutoDiscovery(t *testing.T) {
+	resourceName := "aws_iam_openid_connect_provider.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckOpenIDConnectProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenIDConnectProviderConfig_thumbprintAutoDiscovery(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpenIDConnectProvider(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "thumbprint_auto_discovery", "true"),
+					resource.TestCheckResourceAttr(resourceName, "thumbprint_list.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "thumbprint_list.0", regexp.MustCompile(`^[0-9a-f]{40}$`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"thumbprint_auto_discovery"},
+			},
+		},
+	})
+}
+
+func TestAccIAMOpenIDConnectProvider_thumbprintRequired(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckOpenIDConnectProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOpenIDConnectProviderConfig_noThumbprint(),
+				ExpectError: regexp.MustCompile(`one of "thumbprint_list" or "thumbprint_auto_discovery" must be configured`),
+			},
+		},
+	})
+}
+
 func testAccCheckOpenIDConnectProviderDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
 
@@ -241,3 +285,29 @@ resource "aws_iam_openid_connect_provider" "test" {
 }
 `, rString, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccOpenIDConnectProviderConfig_thumbprintAutoDiscovery() string {
+	return `
+resource "aws_iam_openid_connect_provider" "test" {
+  url = "https://token.actions.githubusercontent.com"
+
+  client_id_list = [
+    "sts.amazonaws.com",
+  ]
+
+  thumbprint_auto_discovery = true
+}
+`
+}
+
+func testAccOpenIDConnectProviderConfig_noThumbprint() string {
+	return `
+resource "aws_iam_openid_connect_provider" "test" {
+  url = "https://token.actions.githubusercontent.com"
+
+  client_id_list = [
+    "sts.amazonaws.com",
+  ]
+}
+`
+}
@@ -0,0 +1,103 @@
+package iam
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	openIDConfigurationPath = "/.well-known/openid-configuration"
+)
+
+// openIDConfiguration is the subset of the OpenID Provider Metadata used to locate the JSON Web Key Set.
+type openIDConfiguration struct {
+	JWKSURI string `json:"jwks_uri"`
+}
+
+// findOpenIDConnectProviderThumbprint returns the thumbprint that IAM expects for the specified OIDC issuer.
+// It follows the issuer's discovery document to the JSON Web Key Set (JWKS) endpoint and returns the
+// hex-encoded SHA-1 fingerprint of the top intermediate CA certificate, i.e. the last certificate in the
+// chain presented by that endpoint.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_providers_create_oidc_verify-thumbprint.html.
+func findOpenIDConnectProviderThumbprint(ctx context.Context, client *http.Client, issuerURL string) (string, error) {
+	if !strings.Contains(issuerURL, "://") {
+		issuerURL = "https://" + issuerURL
+	}
+
+	configurationURL := strings.TrimSuffix(issuerURL, "/") + openIDConfigurationPath
+	response, err := openIDConnectProviderGet(ctx, client, configurationURL)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer response.Body.Close()
+
+	var configuration openIDConfiguration
+
+	if err := json.NewDecoder(response.Body).Decode(&configuration); err != nil {
+		return "", fmt.Errorf("decoding OpenID configuration (%s): %w", configurationURL, err)
+	}
+
+	if configuration.JWKSURI == "" {
+		return "", fmt.Errorf("OpenID configuration (%s) has no jwks_uri", configurationURL)
+	}
+
+	jwksURL, err := url.Parse(configuration.JWKSURI)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing jwks_uri (%s): %w", configuration.JWKSURI, err)
+	}
+
+	if jwksURL.Scheme != "https" {
+		return "", fmt.Errorf("jwks_uri (%s) does not use HTTPS", configuration.JWKSURI)
+	}
+
+	response, err = openIDConnectProviderGet(ctx, client, jwksURL.String())
+
+	if err != nil {
+		return "", err
+	}
+
+	defer response.Body.Close()
+
+	if response.TLS == nil || len(response.TLS.PeerCertificates) == 0 {
+		return "", fmt.Errorf("no TLS certificates presented by %s", jwksURL.Host)
+	}
+
+	certificates := response.TLS.PeerCertificates
+	sum := sha1.Sum(certificates[len(certificates)-1].Raw) //nolint:gosec // IAM thumbprints are SHA-1 fingerprints.
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func openIDConnectProviderGet(ctx context.Context, client *http.Client, requestURL string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("creating request (%s): %w", requestURL, err)
+	}
+
+	response, err := client.Do(request)
+
+	if err != nil {
+		return nil, fmt.Errorf("requesting %s: %w", requestURL, err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		// Drain the body so the connection can be reused.
+		_, _ = io.Copy(io.Discard, response.Body)
+		response.Body.Close()
+
+		return nil, fmt.Errorf("requesting %s: unexpected HTTP status %s", requestURL, response.Status)
+	}
+
+	return response, nil
+}
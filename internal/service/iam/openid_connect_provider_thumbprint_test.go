@@ -0,0 +1,254 @@
+package iam
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testCertificateChain is a root CA -> intermediate CA -> leaf certificate chain for 127.0.0.1.
+type testCertificateChain struct {
+	root         *x509.Certificate
+	intermediate *x509.Certificate
+	// serving is the leaf certificate followed by the intermediate certificate, as a server presents them.
+	serving tls.Certificate
+}
+
+func newTestCertificateChain(t *testing.T, name string) testCertificateChain {
+	t.Helper()
+
+	newCertificate := func(template, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if parent == nil {
+			parent, parentKey = template, key
+		}
+
+		der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		certificate, err := x509.ParseCertificate(der)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return certificate, key
+	}
+
+	notBefore := time.Now().Add(-time.Hour)
+	notAfter := time.Now().Add(time.Hour)
+
+	root, rootKey := newCertificate(&x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name + " Root CA"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}, nil, nil)
+
+	intermediate, intermediateKey := newCertificate(&x509.Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: name + " Intermediate CA"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}, root, rootKey)
+
+	leaf, leafKey := newCertificate(&x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}, intermediate, intermediateKey)
+
+	return testCertificateChain{
+		root:         root,
+		intermediate: intermediate,
+		serving: tls.Certificate{
+			Certificate: [][]byte{leaf.Raw, intermediate.Raw},
+			PrivateKey:  leafKey,
+		},
+	}
+}
+
+func newTestTLSServer(handler http.Handler, chain testCertificateChain) *httptest.Server {
+	server := httptest.NewUnstartedServer(handler)
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{chain.serving},
+	}
+	server.StartTLS()
+
+	return server
+}
+
+func testThumbprint(certificate *x509.Certificate) string {
+	sum := sha1.Sum(certificate.Raw)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func TestFindOpenIDConnectProviderThumbprint(t *testing.T) {
+	chain := newTestCertificateChain(t, "issuer")
+	mux := http.NewServeMux()
+	server := newTestTLSServer(mux, chain)
+	defer server.Close()
+
+	// The JWKS endpoint can be served from a different host, with a different certificate chain, than the issuer.
+	jwksChain := newTestCertificateChain(t, "jwks")
+	jwksServer := newTestTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"keys":[]}`)
+	}), jwksChain)
+	defer jwksServer.Close()
+
+	mux.HandleFunc("/valid"+openIDConfigurationPath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"issuer":"%[1]s/valid","jwks_uri":"%[1]s/keys"}`, server.URL)
+	})
+	mux.HandleFunc("/no-jwks-uri"+openIDConfigurationPath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"issuer":"%[1]s/no-jwks-uri"}`, server.URL)
+	})
+	mux.HandleFunc("/insecure-jwks-uri"+openIDConfigurationPath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"jwks_uri":"http://example.com/keys"}`)
+	})
+	mux.HandleFunc("/external-jwks-uri"+openIDConfigurationPath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"jwks_uri":"%s/keys"}`, jwksServer.URL)
+	})
+	mux.HandleFunc("/invalid-json"+openIDConfigurationPath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{`)
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"keys":[]}`)
+	})
+
+	// The thumbprint is that of the top intermediate CA certificate presented by the JWKS endpoint.
+	expected := testThumbprint(chain.intermediate)
+	expectedExternal := testThumbprint(jwksChain.intermediate)
+
+	for _, v := range []string{testThumbprint(chain.root), testThumbprint(jwksChain.root), testThumbprint(jwksChain.intermediate)} {
+		if v == expected {
+			t.Fatal("test certificate thumbprints are not distinct")
+		}
+	}
+
+	// Both test servers' root CAs are trusted by the client.
+	roots := x509.NewCertPool()
+	roots.AddCert(chain.root)
+	roots.AddCert(jwksChain.root)
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: roots,
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name          string
+		IssuerURL     string
+		Expected      string
+		ExpectedError string
+	}{
+		{
+			Name:      "valid",
+			IssuerURL: server.URL + "/valid",
+			Expected:  expected,
+		},
+		{
+			Name:      "trailing slash",
+			IssuerURL: server.URL + "/valid/",
+			Expected:  expected,
+		},
+		{
+			Name:      "no scheme",
+			IssuerURL: strings.TrimPrefix(server.URL, "https://") + "/valid",
+			Expected:  expected,
+		},
+		{
+			Name:      "external jwks_uri",
+			IssuerURL: server.URL + "/external-jwks-uri",
+			Expected:  expectedExternal,
+		},
+		{
+			Name:          "not found",
+			IssuerURL:     server.URL + "/missing",
+			ExpectedError: "unexpected HTTP status 404",
+		},
+		{
+			Name:          "no jwks_uri",
+			IssuerURL:     server.URL + "/no-jwks-uri",
+			ExpectedError: "has no jwks_uri",
+		},
+		{
+			Name:          "insecure jwks_uri",
+			IssuerURL:     server.URL + "/insecure-jwks-uri",
+			ExpectedError: "does not use HTTPS",
+		},
+		{
+			Name:          "invalid JSON",
+			IssuerURL:     server.URL + "/invalid-json",
+			ExpectedError: "decoding OpenID configuration",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := findOpenIDConnectProviderThumbprint(context.Background(), client, testCase.IssuerURL)
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFindOpenIDConnectProviderThumbprint_untrustedCertificate(t *testing.T) {
+	server := newTestTLSServer(http.NotFoundHandler(), newTestCertificateChain(t, "untrusted"))
+	defer server.Close()
+
+	// The default client does not trust the test server's root CA.
+	_, err := findOpenIDConnectProviderThumbprint(context.Background(), &http.Client{}, server.URL)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
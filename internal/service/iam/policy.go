@@ -1,6 +1,7 @@
 package iam
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -73,11 +75,15 @@ func ResourcePolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags":     tftags.TagsSchema(),
-			"tags_all": tftags.TagsSchemaComputed(),
+			"tags":       tftags.TagsSchema(),
+			"tags_all":   tftags.TagsSchemaComputed(),
+			"validation": policyValidationSchema(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourcePolicyCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -418,3 +424,21 @@ func policyListVersions(arn string, conn *iam.IAM) ([]*iam.PolicyVersion, error)
 	}
 	return response.Versions, nil
 }
+
+func resourcePolicyCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.GetOk("validation")
+
+	if !ok || len(v.([]interface{})) == 0 {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChanges("policy", "validation") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("policy") {
+		return nil
+	}
+
+	return validatePolicyDiff(ctx, meta.(*conns.AWSClient).AccessAnalyzerConn, diff.Get("policy").(string), v.([]interface{}))
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -279,6 +280,35 @@ func TestAccIAMPolicyDocumentDataSource_StatementPrincipalIdentifiers_multiplePr
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_validation(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(accessanalyzer.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID, accessanalyzer.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_validation("s3:GetObjectz", "*", "ERROR"),
+				ExpectError: regexp.MustCompile(`IAM policy validation ERROR: INVALID_ACTION`),
+			},
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_validation("iam:PassRole", "*", "SECURITY_WARNING"),
+				ExpectError: regexp.MustCompile(`IAM policy validation SECURITY_WARNING: PASS_ROLE_WITH_STAR_IN_RESOURCE`),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_validation("iam:PassRole", "*", "ERROR"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "json"),
+					resource.TestCheckResourceAttr(dataSourceName, "validation.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "validation.0.error_severity", "ERROR"),
+					resource.TestCheckResourceAttr(dataSourceName, "validation.0.policy_type", "IDENTITY_POLICY"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIAMPolicyDocumentDataSource_version20081017(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
@@ -1320,3 +1350,18 @@ func testAccPolicyDocumentExpectedJSONStatementPrincipalIdentifiersMultiplePrinc
   ]
 }`, acctest.Partition())
 }
+
+func testAccPolicyDocumentDataSourceConfig_validation(action, resource, errorSeverity string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = [%[1]q]
+    resources = [%[2]q]
+  }
+
+  validation {
+    error_severity = %[3]q
+  }
+}
+`, action, resource, errorSeverity)
+}
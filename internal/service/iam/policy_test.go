@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -239,6 +240,42 @@ func TestAccIAMPolicy_policy(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicy_validation(t *testing.T) {
+	var out iam.GetPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_policy.test"
+	policy1 := `{"Statement":[{"Action":["ec2:DescribeInstancez"],"Effect":"Allow","Resource":"*"}],"Version":"2012-10-17"}`
+	policy2 := `{"Statement":[{"Action":["ec2:Describe*"],"Effect":"Allow","Resource":"*"}],"Version":"2012-10-17"}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(accessanalyzer.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID, accessanalyzer.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfig_validation(rName, policy1),
+				ExpectError: regexp.MustCompile(`IAM policy validation ERROR: INVALID_ACTION`),
+			},
+			{
+				Config: testAccPolicyConfig_validation(rName, policy2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(resourceName, &out),
+					resource.TestCheckResourceAttr(resourceName, "policy", policy2),
+					resource.TestCheckResourceAttr(resourceName, "validation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "validation.0.error_severity", "ERROR"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validation"},
+			},
+		},
+	})
+}
+
 func testAccCheckPolicyExists(resource string, res *iam.GetPolicyOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resource]
@@ -394,6 +431,17 @@ resource "aws_iam_policy" "test" {
 `, rName, policy)
 }
 
+func testAccPolicyConfig_validation(rName, policy string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  name   = %q
+  policy = %q
+
+  validation {}
+}
+`, rName, policy)
+}
+
 func testAccPolicyConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_policy" "test" {
@@ -0,0 +1,128 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// policyValidationFindingSeverities orders Access Analyzer policy validation finding types from least to most severe.
+var policyValidationFindingSeverities = map[string]int{
+	accessanalyzer.ValidatePolicyFindingTypeSuggestion:      0,
+	accessanalyzer.ValidatePolicyFindingTypeWarning:         1,
+	accessanalyzer.ValidatePolicyFindingTypeSecurityWarning: 2,
+	accessanalyzer.ValidatePolicyFindingTypeError:           3,
+}
+
+func policyValidationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"error_severity": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      accessanalyzer.ValidatePolicyFindingTypeError,
+					ValidateFunc: validation.StringInSlice(accessanalyzer.ValidatePolicyFindingType_Values(), false),
+				},
+				"policy_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      accessanalyzer.PolicyTypeIdentityPolicy,
+					ValidateFunc: validation.StringInSlice(accessanalyzer.PolicyType_Values(), false),
+				},
+				"validate_policy_resource_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(accessanalyzer.ValidatePolicyResourceType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+// validatePolicy validates the specified policy document with IAM Access Analyzer.
+// Findings at or above the configured error severity are returned as errors, all other findings as warnings.
+func validatePolicy(ctx context.Context, conn *accessanalyzer.AccessAnalyzer, policy string, tfList []interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(tfList) == 0 {
+		return diags
+	}
+
+	// An empty validation block enables validation with the default settings.
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok || tfMap == nil {
+		tfMap = map[string]interface{}{}
+	}
+
+	errorSeverity := accessanalyzer.ValidatePolicyFindingTypeError
+	if v, ok := tfMap["error_severity"].(string); ok && v != "" {
+		errorSeverity = v
+	}
+
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(policy),
+		PolicyType:     aws.String(accessanalyzer.PolicyTypeIdentityPolicy),
+	}
+
+	if v, ok := tfMap["policy_type"].(string); ok && v != "" {
+		input.PolicyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["validate_policy_resource_type"].(string); ok && v != "" {
+		input.ValidatePolicyResourceType = aws.String(v)
+	}
+
+	findings, err := FindValidatePolicyFindings(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("validating IAM policy: %s", err)
+	}
+
+	for _, finding := range findings {
+		findingType := aws.StringValue(finding.FindingType)
+		severity := diag.Warning
+
+		if policyValidationFindingSeverities[findingType] >= policyValidationFindingSeverities[errorSeverity] {
+			severity = diag.Error
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  fmt.Sprintf("IAM policy validation %s: %s", findingType, aws.StringValue(finding.IssueCode)),
+			Detail:   fmt.Sprintf("%s\n\nLearn more: %s", aws.StringValue(finding.FindingDetails), aws.StringValue(finding.LearnMoreLink)),
+		})
+	}
+
+	return diags
+}
+
+// validatePolicyDiff validates the specified policy document during plan.
+// CustomizeDiff cannot return warnings so findings below the configured error severity are logged.
+func validatePolicyDiff(ctx context.Context, conn *accessanalyzer.AccessAnalyzer, policy string, tfList []interface{}) error {
+	var errs *multierror.Error
+
+	for _, d := range validatePolicy(ctx, conn, policy, tfList) {
+		if d.Severity == diag.Error {
+			errs = multierror.Append(errs, errors.New(strings.TrimSpace(fmt.Sprintf("%s\n%s", d.Summary, d.Detail))))
+			continue
+		}
+
+		log.Printf("[WARN] %s: %s", d.Summary, d.Detail)
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -1,6 +1,7 @@
 package iam
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -150,6 +152,8 @@ func ResourceRole() *schema.Resource {
 				},
 			},
 
+			"inline_policy_validation": policyValidationSchema(),
+
 			"managed_policy_arns": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -161,7 +165,10 @@ func ResourceRole() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceRoleCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -313,8 +320,8 @@ func resourceRoleRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	managedPolicies, err := readRolePolicyAttachments(conn, aws.StringValue(role.RoleName))
-	if err != nil {
+	managedPolicies, err := FindRoleAttachedPolicyARNs(conn, aws.StringValue(role.RoleName))
+	if err != nil && !tfresource.NotFound(err) {
 		return fmt.Errorf("reading managed policies for IAM role %s, error: %s", d.Id(), err)
 	}
 	d.Set("managed_policy_arns", managedPolicies)
@@ -530,8 +537,8 @@ func DeleteRole(conn *iam.IAM, roleName string, forceDetach, hasInline, hasManag
 	}
 
 	if forceDetach || hasManaged {
-		managedPolicies, err := readRolePolicyAttachments(conn, roleName)
-		if err != nil {
+		managedPolicies, err := FindRoleAttachedPolicyARNs(conn, roleName)
+		if err != nil && !tfresource.NotFound(err) {
 			return err
 		}
 
@@ -541,8 +548,8 @@ func DeleteRole(conn *iam.IAM, roleName string, forceDetach, hasInline, hasManag
 	}
 
 	if forceDetach || hasInline {
-		inlinePolicies, err := readRolePolicyNames(conn, roleName)
-		if err != nil {
+		inlinePolicies, err := FindRolePolicyNames(conn, roleName)
+		if err != nil && !tfresource.NotFound(err) {
 			return err
 		}
 
@@ -629,25 +636,6 @@ func retryCreateRole(conn *iam.IAM, input *iam.CreateRoleInput) (*iam.CreateRole
 	return output, err
 }
 
-func readRolePolicyAttachments(conn *iam.IAM, roleName string) ([]*string, error) {
-	managedPolicies := make([]*string, 0)
-	input := &iam.ListAttachedRolePoliciesInput{
-		RoleName: aws.String(roleName),
-	}
-
-	err := conn.ListAttachedRolePoliciesPages(input, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
-		for _, v := range page.AttachedPolicies {
-			managedPolicies = append(managedPolicies, v.PolicyArn)
-		}
-		return !lastPage
-	})
-	if err != nil && !tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-		return nil, err
-	}
-
-	return managedPolicies, nil
-}
-
 func deleteRolePolicyAttachments(conn *iam.IAM, roleName string, managedPolicies []*string) error {
 	for _, parn := range managedPolicies {
 		input := &iam.DetachRolePolicyInput{
@@ -667,24 +655,6 @@ func deleteRolePolicyAttachments(conn *iam.IAM, roleName string, managedPolicies
 	return nil
 }
 
-func readRolePolicyNames(conn *iam.IAM, roleName string) ([]*string, error) {
-	inlinePolicies := make([]*string, 0)
-	input := &iam.ListRolePoliciesInput{
-		RoleName: aws.String(roleName),
-	}
-
-	err := conn.ListRolePoliciesPages(input, func(page *iam.ListRolePoliciesOutput, lastPage bool) bool {
-		inlinePolicies = append(inlinePolicies, page.PolicyNames...)
-		return !lastPage
-	})
-
-	if err != nil && !tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-		return nil, err
-	}
-
-	return inlinePolicies, nil
-}
-
 func deleteRolePolicies(conn *iam.IAM, roleName string, policyNames []*string) error {
 	for _, name := range policyNames {
 		if len(aws.StringValue(name)) == 0 {
@@ -822,8 +792,8 @@ func addRoleManagedPolicies(roleName string, policies []*string, meta interface{
 func readRoleInlinePolicies(roleName string, meta interface{}) ([]*iam.PutRolePolicyInput, error) {
 	conn := meta.(*conns.AWSClient).IAMConn
 
-	policyNames, err := readRolePolicyNames(conn, roleName)
-	if err != nil {
+	policyNames, err := FindRolePolicyNames(conn, roleName)
+	if err != nil && !tfresource.NotFound(err) {
 		return nil, err
 	}
 
@@ -905,3 +875,42 @@ func inlinePoliciesEquivalent(one, two []*iam.PutRolePolicyInput) bool {
 
 	return matches == len(one)
 }
+
+func resourceRoleCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.GetOk("inline_policy_validation")
+
+	if !ok || len(v.([]interface{})) == 0 {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChanges("inline_policy", "inline_policy_validation") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("inline_policy") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+	var errs *multierror.Error
+
+	for _, tfMapRaw := range diff.Get("inline_policy").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		policy, ok := tfMap["policy"].(string)
+
+		if !ok || policy == "" {
+			continue
+		}
+
+		if err := validatePolicyDiff(ctx, conn, policy, v.([]interface{})); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("inline policy (%s): %w", tfMap["name"], err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,130 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceRolePoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRolePoliciesExclusiveCreate,
+		Read:   resourceRolePoliciesExclusiveRead,
+		Update: resourceRolePoliciesExclusiveUpdate,
+		Delete: resourceRolePoliciesExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceRolePoliciesExclusiveImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRolePoliciesExclusiveCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	roleName := d.Get("role_name").(string)
+
+	if err := syncRolePolicies(conn, roleName, d.Get("policy_names").(*schema.Set)); err != nil {
+		return fmt.Errorf("creating IAM Role Policies Exclusive (%s): %w", roleName, err)
+	}
+
+	d.SetId(roleName)
+
+	return resourceRolePoliciesExclusiveRead(d, meta)
+}
+
+func resourceRolePoliciesExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	policyNames, err := FindRolePolicyNames(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role Policies Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading IAM Role Policies Exclusive (%s): %w", d.Id(), err)
+	}
+
+	d.Set("policy_names", flex.FlattenStringSet(policyNames))
+	d.Set("role_name", d.Id())
+
+	return nil
+}
+
+func resourceRolePoliciesExclusiveUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	if d.HasChange("policy_names") {
+		if err := syncRolePolicies(conn, d.Id(), d.Get("policy_names").(*schema.Set)); err != nil {
+			return fmt.Errorf("updating IAM Role Policies Exclusive (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceRolePoliciesExclusiveRead(d, meta)
+}
+
+func resourceRolePoliciesExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// Destroying this resource only stops exclusive management of the role's inline policies.
+	log.Printf("[DEBUG] Removing IAM Role Policies Exclusive (%s) from state", d.Id())
+
+	return nil
+}
+
+func resourceRolePoliciesExclusiveImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("role_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// syncRolePolicies deletes any inline policies embedded in the specified role that are not in the specified set of policy names.
+func syncRolePolicies(conn *iam.IAM, roleName string, want *schema.Set) error {
+	output, err := FindRolePolicyNames(conn, roleName)
+
+	if err != nil {
+		return fmt.Errorf("reading IAM Role (%s) inline policies: %w", roleName, err)
+	}
+
+	have := flex.FlattenStringSet(output)
+
+	for _, v := range flex.ExpandStringSet(have.Difference(want)) {
+		policyName := aws.StringValue(v)
+		log.Printf("[DEBUG] Deleting IAM Role (%s) inline policy: %s", roleName, policyName)
+		_, err := conn.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+			PolicyName: aws.String(policyName),
+			RoleName:   aws.String(roleName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting IAM Role (%s) inline policy (%s): %w", roleName, policyName, err)
+		}
+	}
+
+	return nil
+}
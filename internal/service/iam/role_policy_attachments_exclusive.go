@@ -0,0 +1,139 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRolePolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRolePolicyAttachmentsExclusiveCreate,
+		Read:   resourceRolePolicyAttachmentsExclusiveRead,
+		Update: resourceRolePolicyAttachmentsExclusiveUpdate,
+		Delete: resourceRolePolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceRolePolicyAttachmentsExclusiveImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRolePolicyAttachmentsExclusiveCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	roleName := d.Get("role_name").(string)
+
+	if err := syncRolePolicyAttachments(conn, roleName, d.Get("policy_arns").(*schema.Set)); err != nil {
+		return fmt.Errorf("creating IAM Role Policy Attachments Exclusive (%s): %w", roleName, err)
+	}
+
+	d.SetId(roleName)
+
+	return resourceRolePolicyAttachmentsExclusiveRead(d, meta)
+}
+
+func resourceRolePolicyAttachmentsExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	policyARNs, err := FindRoleAttachedPolicyARNs(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role Policy Attachments Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading IAM Role Policy Attachments Exclusive (%s): %w", d.Id(), err)
+	}
+
+	d.Set("policy_arns", flex.FlattenStringSet(policyARNs))
+	d.Set("role_name", d.Id())
+
+	return nil
+}
+
+func resourceRolePolicyAttachmentsExclusiveUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	if d.HasChange("policy_arns") {
+		if err := syncRolePolicyAttachments(conn, d.Id(), d.Get("policy_arns").(*schema.Set)); err != nil {
+			return fmt.Errorf("updating IAM Role Policy Attachments Exclusive (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceRolePolicyAttachmentsExclusiveRead(d, meta)
+}
+
+func resourceRolePolicyAttachmentsExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// Destroying this resource only stops exclusive management of the role's managed policy attachments.
+	log.Printf("[DEBUG] Removing IAM Role Policy Attachments Exclusive (%s) from state", d.Id())
+
+	return nil
+}
+
+func resourceRolePolicyAttachmentsExclusiveImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("role_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// syncRolePolicyAttachments attaches the specified managed policies to the specified role and detaches any others.
+func syncRolePolicyAttachments(conn *iam.IAM, roleName string, want *schema.Set) error {
+	output, err := FindRoleAttachedPolicyARNs(conn, roleName)
+
+	if err != nil {
+		return fmt.Errorf("reading IAM Role (%s) managed policy attachments: %w", roleName, err)
+	}
+
+	have := flex.FlattenStringSet(output)
+
+	for _, v := range flex.ExpandStringSet(want.Difference(have)) {
+		policyARN := aws.StringValue(v)
+		log.Printf("[DEBUG] Attaching IAM Role (%s) managed policy: %s", roleName, policyARN)
+		if err := attachPolicyToRole(conn, roleName, policyARN); err != nil {
+			return fmt.Errorf("attaching IAM Role (%s) managed policy (%s): %w", roleName, policyARN, err)
+		}
+	}
+
+	for _, v := range flex.ExpandStringSet(have.Difference(want)) {
+		policyARN := aws.StringValue(v)
+		log.Printf("[DEBUG] Detaching IAM Role (%s) managed policy: %s", roleName, policyARN)
+		err := DetachPolicyFromRole(conn, roleName, policyARN)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("detaching IAM Role (%s) managed policy (%s): %w", roleName, policyARN, err)
+		}
+	}
+
+	return nil
+}
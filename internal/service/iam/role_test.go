@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -450,6 +451,41 @@ func TestAccIAMRole_InlinePolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccIAMRole_InlinePolicy_validation(t *testing.T) {
+	var role iam.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	policyName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(accessanalyzer.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID, accessanalyzer.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRoleConfig_policyInlineValidation(rName, policyName, "ec2:DescribeInstancez"),
+				ExpectError: regexp.MustCompile(`IAM policy validation ERROR: INVALID_ACTION`),
+			},
+			{
+				Config: testAccRoleConfig_policyInlineValidation(rName, policyName, "ec2:Describe*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(resourceName, &role),
+					resource.TestCheckResourceAttr(resourceName, "inline_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inline_policy_validation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inline_policy_validation.0.error_severity", "ERROR"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"inline_policy_validation"},
+			},
+		},
+	})
+}
+
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19444
 // This test currently fails but should not. A new PR will fix it.
 func TestAccIAMRole_InlinePolicy_ignoreOrder(t *testing.T) {
@@ -1513,6 +1549,42 @@ EOF
 `, rName)
 }
 
+func testAccRoleConfig_policyInlineValidation(roleName, policyName, action string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+
+  inline_policy {
+    name = %[2]q
+
+    policy = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Action   = [%[3]q]
+        Effect   = "Allow"
+        Resource = "*"
+      }]
+    })
+  }
+
+  inline_policy_validation {}
+}
+`, roleName, policyName, action)
+}
+
 func testAccRoleConfig_policyInline(roleName, policyName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -0,0 +1,130 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceUserPoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUserPoliciesExclusiveCreate,
+		Read:   resourceUserPoliciesExclusiveRead,
+		Update: resourceUserPoliciesExclusiveUpdate,
+		Delete: resourceUserPoliciesExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceUserPoliciesExclusiveImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUserPoliciesExclusiveCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	userName := d.Get("user_name").(string)
+
+	if err := syncUserPolicies(conn, userName, d.Get("policy_names").(*schema.Set)); err != nil {
+		return fmt.Errorf("creating IAM User Policies Exclusive (%s): %w", userName, err)
+	}
+
+	d.SetId(userName)
+
+	return resourceUserPoliciesExclusiveRead(d, meta)
+}
+
+func resourceUserPoliciesExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	policyNames, err := FindUserPolicyNames(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM User Policies Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading IAM User Policies Exclusive (%s): %w", d.Id(), err)
+	}
+
+	d.Set("policy_names", flex.FlattenStringSet(policyNames))
+	d.Set("user_name", d.Id())
+
+	return nil
+}
+
+func resourceUserPoliciesExclusiveUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	if d.HasChange("policy_names") {
+		if err := syncUserPolicies(conn, d.Id(), d.Get("policy_names").(*schema.Set)); err != nil {
+			return fmt.Errorf("updating IAM User Policies Exclusive (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceUserPoliciesExclusiveRead(d, meta)
+}
+
+func resourceUserPoliciesExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// Destroying this resource only stops exclusive management of the user's inline policies.
+	log.Printf("[DEBUG] Removing IAM User Policies Exclusive (%s) from state", d.Id())
+
+	return nil
+}
+
+func resourceUserPoliciesExclusiveImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("user_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// syncUserPolicies deletes any inline policies embedded in the specified user that are not in the specified set of policy names.
+func syncUserPolicies(conn *iam.IAM, userName string, want *schema.Set) error {
+	output, err := FindUserPolicyNames(conn, userName)
+
+	if err != nil {
+		return fmt.Errorf("reading IAM User (%s) inline policies: %w", userName, err)
+	}
+
+	have := flex.FlattenStringSet(output)
+
+	for _, v := range flex.ExpandStringSet(have.Difference(want)) {
+		policyName := aws.StringValue(v)
+		log.Printf("[DEBUG] Deleting IAM User (%s) inline policy: %s", userName, policyName)
+		_, err := conn.DeleteUserPolicy(&iam.DeleteUserPolicyInput{
+			PolicyName: aws.String(policyName),
+			UserName:   aws.String(userName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting IAM User (%s) inline policy (%s): %w", userName, policyName, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceUserPolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUserPolicyAttachmentsExclusiveCreate,
+		Read:   resourceUserPolicyAttachmentsExclusiveRead,
+		Update: resourceUserPolicyAttachmentsExclusiveUpdate,
+		Delete: resourceUserPolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceUserPolicyAttachmentsExclusiveImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUserPolicyAttachmentsExclusiveCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	userName := d.Get("user_name").(string)
+
+	if err := syncUserPolicyAttachments(conn, userName, d.Get("policy_arns").(*schema.Set)); err != nil {
+		return fmt.Errorf("creating IAM User Policy Attachments Exclusive (%s): %w", userName, err)
+	}
+
+	d.SetId(userName)
+
+	return resourceUserPolicyAttachmentsExclusiveRead(d, meta)
+}
+
+func resourceUserPolicyAttachmentsExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	policyARNs, err := FindUserAttachedPolicyARNs(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM User Policy Attachments Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading IAM User Policy Attachments Exclusive (%s): %w", d.Id(), err)
+	}
+
+	d.Set("policy_arns", flex.FlattenStringSet(policyARNs))
+	d.Set("user_name", d.Id())
+
+	return nil
+}
+
+func resourceUserPolicyAttachmentsExclusiveUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	if d.HasChange("policy_arns") {
+		if err := syncUserPolicyAttachments(conn, d.Id(), d.Get("policy_arns").(*schema.Set)); err != nil {
+			return fmt.Errorf("updating IAM User Policy Attachments Exclusive (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceUserPolicyAttachmentsExclusiveRead(d, meta)
+}
+
+func resourceUserPolicyAttachmentsExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// Destroying this resource only stops exclusive management of the user's managed policy attachments.
+	log.Printf("[DEBUG] Removing IAM User Policy Attachments Exclusive (%s) from state", d.Id())
+
+	return nil
+}
+
+func resourceUserPolicyAttachmentsExclusiveImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("user_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// syncUserPolicyAttachments attaches the specified managed policies to the specified user and detaches any others.
+func syncUserPolicyAttachments(conn *iam.IAM, userName string, want *schema.Set) error {
+	output, err := FindUserAttachedPolicyARNs(conn, userName)
+
+	if err != nil {
+		return fmt.Errorf("reading IAM User (%s) managed policy attachments: %w", userName, err)
+	}
+
+	have := flex.FlattenStringSet(output)
+
+	for _, v := range flex.ExpandStringSet(want.Difference(have)) {
+		policyARN := aws.StringValue(v)
+		log.Printf("[DEBUG] Attaching IAM User (%s) managed policy: %s", userName, policyARN)
+		if err := attachPolicyToUser(conn, userName, policyARN); err != nil {
+			return fmt.Errorf("attaching IAM User (%s) managed policy (%s): %w", userName, policyARN, err)
+		}
+	}
+
+	for _, v := range flex.ExpandStringSet(have.Difference(want)) {
+		policyARN := aws.StringValue(v)
+		log.Printf("[DEBUG] Detaching IAM User (%s) managed policy: %s", userName, policyARN)
+		err := DetachPolicyFromUser(conn, userName, policyARN)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("detaching IAM User (%s) managed policy (%s): %w", userName, policyARN, err)
+		}
+	}
+
+	return nil
+}
@@ -1,16 +1,101 @@
 package kendra_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kendra"
+	"github.com/aws/aws-sdk-go-v2/service/kendra/types"
 	"github.com/aws/aws-sdk-go/service/backup"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
+	tfkendra "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
 )
 
+const (
+	thesaurusStubIndexID     = "12345678-1234-1234-1234-123456789012"
+	thesaurusStubThesaurusID = "87654321-4321-4321-4321-210987654321"
+)
+
+type thesaurusStub struct{}
+
+func (thesaurusStub) DescribeThesaurus(_ context.Context, input *kendra.DescribeThesaurusInput, _ ...func(*kendra.Options)) (*kendra.DescribeThesaurusOutput, error) {
+	if aws.ToString(input.Id) != thesaurusStubThesaurusID {
+		return nil, &types.ResourceNotFoundException{Message: aws.String("thesaurus not found")}
+	}
+
+	return &kendra.DescribeThesaurusOutput{
+		CreatedAt:        aws.Time(time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)),
+		Id:               input.Id,
+		IndexId:          input.IndexId,
+		Name:             aws.String("test"),
+		SourceS3Path:     &types.S3Path{Bucket: aws.String("bucket"), Key: aws.String("key")},
+		Status:           types.ThesaurusStatusActive,
+		SynonymRuleCount: aws.Int64(2),
+		TermCount:        aws.Int64(4),
+		UpdatedAt:        aws.Time(time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)),
+	}, nil
+}
+
+func (thesaurusStub) ListTagsForResource(_ context.Context, _ *kendra.ListTagsForResourceInput, _ ...func(*kendra.Options)) (*kendra.ListTagsForResourceOutput, error) {
+	return &kendra.ListTagsForResourceOutput{
+		Tags: []types.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}},
+	}, nil
+}
+
+func TestThesaurusDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	client := fake.Client()
+	client.KendraConn = fake.ServiceV2(kendra.NewFromConfig, thesaurusStub{}).(*kendra.Client)
+	r := tfkendra.DataSourceThesaurus()
+	d := fake.ResourceData(t, r, map[string]interface{}{
+		"index_id":     thesaurusStubIndexID,
+		"thesaurus_id": thesaurusStubThesaurusID,
+	})
+
+	if diags := fake.Read(ctx, r, d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	for k, want := range map[string]string{
+		"arn":                  fmt.Sprintf("arn:aws:kendra:%s:%s:index/%s/thesaurus/%s", fake.Region, fake.AccountID, thesaurusStubIndexID, thesaurusStubThesaurusID),
+		"created_at":           "2022-01-02T03:04:05Z",
+		"name":                 "test",
+		"source_s3_path.0.key": "key",
+		"status":               string(types.ThesaurusStatusActive),
+		"tags.key1":            "value1",
+	} {
+		if got := d.Get(k).(string); got != want {
+			t.Errorf("%s: got %q, expected %q", k, got, want)
+		}
+	}
+
+	if got, want := d.Get("term_count").(int), 4; got != want {
+		t.Errorf("term_count: got %d, expected %d", got, want)
+	}
+}
+
+func TestThesaurusDataSourceRead_notFound(t *testing.T) {
+	ctx := context.Background()
+	client := fake.Client()
+	client.KendraConn = fake.ServiceV2(kendra.NewFromConfig, thesaurusStub{}).(*kendra.Client)
+	r := tfkendra.DataSourceThesaurus()
+	d := fake.ResourceData(t, r, map[string]interface{}{
+		"index_id":     thesaurusStubIndexID,
+		"thesaurus_id": "does-not-exist",
+	})
+
+	if diags := fake.Read(ctx, r, d, client); !diags.HasError() {
+		t.Fatal("expected error")
+	}
+}
+
 func testAccThesaurusDataSource_basic(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -1,34 +1,38 @@
 package logs_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
 	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
 )
 
 func TestAccLogsGroup_basic(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	resourceName := "aws_cloudwatch_log_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
-		CheckDestroy:      testAccCheckGroupDestroy,
+		CheckDestroy:      testAccCheckGroupDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccGroupConfig_basic(rInt),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupExists(resourceName, &lg),
+					testAccCheckGroupExists(t, resourceName, &lg),
 					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "logs", fmt.Sprintf("log-group:foo-bar-%d", rInt)),
 					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("foo-bar-%d", rInt)),
 					resource.TestCheckResourceAttr(resourceName, "retention_in_days", "0"),
@@ -49,16 +53,16 @@ func TestAccLogsGroup_namePrefix(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
 	resourceName := "aws_cloudwatch_log_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
-		CheckDestroy:      testAccCheckGroupDestroy,
+		CheckDestroy:      testAccCheckGroupDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccGroupConfig_namePrefix,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupExists(resourceName, &lg),
+					testAccCheckGroupExists(t, resourceName, &lg),
 					resource.TestMatchResourceAttr(resourceName, "name", regexp.MustCompile("^tf-test-")),
 				),
 			},
@@ -74,19 +78,19 @@ func TestAccLogsGroup_namePrefix(t *testing.T) {
 
 func TestAccLogsGroup_NamePrefix_retention(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 	resourceName := "aws_cloudwatch_log_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
-		CheckDestroy:      testAccCheckGroupDestroy,
+		CheckDestroy:      testAccCheckGroupDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccGroupConfig_namePrefixRetention(rName, 365),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupExists(resourceName, &lg),
+					testAccCheckGroupExists(t, resourceName, &lg),
 					resource.TestMatchResourceAttr(resourceName, "name", regexp.MustCompile("^tf-test-")),
 					resource.TestCheckResourceAttr(resourceName, "retention_in_days", "365"),
 				),
@@ -100,7 +104,7 @@ func TestAccLogsGroup_NamePrefix_retention(t *testing.T) {
 			{
 				Config: testAccGroupConfig_namePrefixRetention(rName, 7),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupExists(resourceName, &lg),
+					testAccCheckGroupExists(t, resourceName, &lg),
 					resource.TestMatchResourceAttr(resourceName, "name", regexp.MustCompile("^tf-test-")),
 					resource.TestCheckResourceAttr(resourceName, "retention_in_days", "7"),
 				),
@@ -113,16 +117,16 @@ func TestAccLogsGroup_generatedName(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
 	resourceName := "aws_cloudwatch_log_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
-		CheckDestroy:      testAccCheckGroupDestroy,
+		CheckDestroy:      testAccCheckGroupDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccGroupConfig_generatedName,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupExists(resourceName, &lg),
+					testAccCheckGroupExists(t, resourceName, &lg),
 				),
 			},
 			{
@@ -137,19 +141,19 @@ func TestAccLogsGroup_generatedName(t *testing.T) {
 
 func TestAccLogsGroup_retentionPolicy(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	resourceName := "aws_cloudwatch_log_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
-		CheckDestroy:      testAccCheckGroupDestroy,
+		CheckDestroy:      testAccCheckGroupDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccGroupConfig_retention(rInt),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupExists(resourceName, &lg),
+					testAccCheckGroupExists(t, resourceName, &lg),
 					resource.TestCheckResourceAttr(resourceName, "retention_in_days", "365"),
 				),
 			},
@@ -162,7 +166,7 @@ func TestAccLogsGroup_retentionPolicy(t *testing.T) {
 			{
 				Config: testAccGroupConfig_modifiedRetention(rInt),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupExists(resourceName, &lg),
+					testAccCheckGroupExists(t, resourceName, &lg),
 					resource.TestCheckResourceAttr(resourceName, "retention_in_days", "0"),
 				),
 			},
@@ -172,23 +176,23 @@ func TestAccLogsGroup_retentionPolicy(t *testing.T) {
 
 func TestAccLogsGroup_multiple(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	resourceName := "aws_cloudwatch_log_group.alpha"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
-		CheckDestroy:      testAccCheckGroupDestroy,
+		CheckDestroy:      testAccCheckGroupDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccGroupConfig_multiple(rInt),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupExists("aws_cloudwatch_log_group.alpha", &lg),
+					testAccCheckGroupExists(t, "aws_cloudwatch_log_group.alpha", &lg),
 					resource.TestCheckResourceAttr("aws_cloudwatch_log_group.alpha", "retention_in_days", "14"),
-					testAccCheckGroupExists("aws_cloudwatch_log_group.beta", &lg),
+					testAccCheckGroupExists(t, "aws_cloudwatch_log_group.beta", &lg),
 					resource.TestCheckResourceAttr("aws_cloudwatch_log_group.beta", "retention_in_days", "0"),
-					testAccCheckGroupExists("aws_cloudwatch_log_group.charlie", &lg),
+					testAccCheckGroupExists(t, "aws_cloudwatch_log_group.charlie", &lg),
 					resource.TestCheckResourceAttr("aws_cloudwatch_log_group.charlie", "retention_in_days", "3653"),
 				),
 			},
@@ -204,20 +208,20 @@ func TestAccLogsGroup_multiple(t *testing.T) {
 
 func TestAccLogsGroup_disappears(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	resourceName := "aws_cloudwatch_log_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
-		CheckDestroy:      testAccCheckGroupDestroy,
+		CheckDestroy:      testAccCheckGroupDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccGroupConfig_basic(rInt),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupExists(resourceName, &lg),
-					testAccCheckGroupDisappears(&lg),
+					testAccCheckGroupExists(t, resourceName, &lg),
+					testAccCheckGroupDisappears(t, &lg),
 				),
 				ExpectNonEmptyPlan: true,
 			},
@@ -227,19 +231,19 @@ func TestAccLogsGroup_disappears(t *testing.T) {
 
 func TestAccLogsGroup_tagging(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	resourceName := "aws_cloudwatch_log_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
-		CheckDestroy:      testAccCheckGroupDestroy,
+		CheckDestroy:      testAccCheckGroupDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccGroupConfig_tags(rInt),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupExists(resourceName, &lg),
+					testAccCheckGroupExists(t, resourceName, &lg),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
 					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "Production"),
 					resource.TestCheckResourceAttr(resourceName, "tags.Foo", "Bar"),
@@ -255,7 +259,7 @@ func TestAccLogsGroup_tagging(t *testing.T) {
 			{
 				Config: testAccGroupConfig_tagsAdded(rInt),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupExists(resourceName, &lg),
+					testAccCheckGroupExists(t, resourceName, &lg),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
 					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "Development"),
 					resource.TestCheckResourceAttr(resourceName, "tags.Foo", "Bar"),
@@ -266,7 +270,7 @@ func TestAccLogsGroup_tagging(t *testing.T) {
 			{
 				Config: testAccGroupConfig_tagsUpdated(rInt),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupExists(resourceName, &lg),
+					testAccCheckGroupExists(t, resourceName, &lg),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
 					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "Development"),
 					resource.TestCheckResourceAttr(resourceName, "tags.Empty", "NotEmpty"),
@@ -277,7 +281,7 @@ func TestAccLogsGroup_tagging(t *testing.T) {
 			{
 				Config: testAccGroupConfig_tags(rInt),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupExists(resourceName, &lg),
+					testAccCheckGroupExists(t, resourceName, &lg),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
 					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "Production"),
 					resource.TestCheckResourceAttr(resourceName, "tags.Foo", "Bar"),
@@ -290,19 +294,19 @@ func TestAccLogsGroup_tagging(t *testing.T) {
 
 func TestAccLogsGroup_kmsKey(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	resourceName := "aws_cloudwatch_log_group.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
 		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
 		ProviderFactories: acctest.ProviderFactories,
-		CheckDestroy:      testAccCheckGroupDestroy,
+		CheckDestroy:      testAccCheckGroupDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccGroupConfig_basic(rInt),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupExists(resourceName, &lg),
+					testAccCheckGroupExists(t, resourceName, &lg),
 				),
 			},
 			{
@@ -314,7 +318,7 @@ func TestAccLogsGroup_kmsKey(t *testing.T) {
 			{
 				Config: testAccGroupConfig_kmsKeyID(rInt),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupExists(resourceName, &lg),
+					testAccCheckGroupExists(t, resourceName, &lg),
 					resource.TestCheckResourceAttrSet(resourceName, "kms_key_id"),
 				),
 			},
@@ -322,9 +326,9 @@ func TestAccLogsGroup_kmsKey(t *testing.T) {
 	})
 }
 
-func testAccCheckGroupDisappears(lg *cloudwatchlogs.LogGroup) resource.TestCheckFunc {
+func testAccCheckGroupDisappears(t *testing.T, lg *cloudwatchlogs.LogGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsConn
+		conn := acctest.ProviderMeta(t).LogsConn
 		opts := &cloudwatchlogs.DeleteLogGroupInput{
 			LogGroupName: lg.LogGroupName,
 		}
@@ -333,14 +337,14 @@ func testAccCheckGroupDisappears(lg *cloudwatchlogs.LogGroup) resource.TestCheck
 	}
 }
 
-func testAccCheckGroupExists(n string, lg *cloudwatchlogs.LogGroup) resource.TestCheckFunc {
+func testAccCheckGroupExists(t *testing.T, n string, lg *cloudwatchlogs.LogGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsConn
+		conn := acctest.ProviderMeta(t).LogsConn
 		logGroup, err := tflogs.LookupGroup(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -355,26 +359,28 @@ func testAccCheckGroupExists(n string, lg *cloudwatchlogs.LogGroup) resource.Tes
 	}
 }
 
-func testAccCheckGroupDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).LogsConn
+func testAccCheckGroupDestroy(t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(t).LogsConn
 
-	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "aws_cloudwatch_log_group" {
-			continue
-		}
-		logGroup, err := tflogs.LookupGroup(conn, rs.Primary.ID)
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_log_group" {
+				continue
+			}
+			logGroup, err := tflogs.LookupGroup(conn, rs.Primary.ID)
 
-		if err != nil {
-			return fmt.Errorf("error reading CloudWatch Log Group (%s): %w", rs.Primary.ID, err)
-		}
+			if err != nil {
+				return fmt.Errorf("error reading CloudWatch Log Group (%s): %w", rs.Primary.ID, err)
+			}
+
+			if logGroup != nil {
+				return fmt.Errorf("Bad: LogGroup still exists: %q", rs.Primary.ID)
+			}
 
-		if logGroup != nil {
-			return fmt.Errorf("Bad: LogGroup still exists: %q", rs.Primary.ID)
 		}
 
+		return nil
 	}
-
-	return nil
 }
 
 func testAccGroupConfig_basic(rInt int) string {
@@ -514,3 +520,135 @@ resource "aws_cloudwatch_log_group" "test" {
 const testAccGroupConfig_generatedName = `
 resource "aws_cloudwatch_log_group" "test" {}
 `
+
+type groupStub struct {
+	cloudwatchlogsiface.CloudWatchLogsAPI
+
+	groups map[string]*cloudwatchlogs.LogGroup
+	tags   map[string]map[string]*string
+}
+
+func newGroupStub() *groupStub {
+	return &groupStub{
+		groups: make(map[string]*cloudwatchlogs.LogGroup),
+		tags:   make(map[string]map[string]*string),
+	}
+}
+
+func (s *groupStub) CreateLogGroup(input *cloudwatchlogs.CreateLogGroupInput) (*cloudwatchlogs.CreateLogGroupOutput, error) {
+	name := aws.StringValue(input.LogGroupName)
+
+	if _, ok := s.groups[name]; ok {
+		return nil, awserr.New(cloudwatchlogs.ErrCodeResourceAlreadyExistsException, "The specified log group already exists", nil)
+	}
+
+	s.groups[name] = &cloudwatchlogs.LogGroup{
+		Arn:          aws.String(fmt.Sprintf("arn:%s:logs:%s:%s:log-group:%s:*", fake.Partition, fake.Region, fake.AccountID, name)),
+		KmsKeyId:     input.KmsKeyId,
+		LogGroupName: input.LogGroupName,
+	}
+	s.tags[name] = input.Tags
+
+	return &cloudwatchlogs.CreateLogGroupOutput{}, nil
+}
+
+func (s *groupStub) PutRetentionPolicy(input *cloudwatchlogs.PutRetentionPolicyInput) (*cloudwatchlogs.PutRetentionPolicyOutput, error) {
+	s.groups[aws.StringValue(input.LogGroupName)].RetentionInDays = input.RetentionInDays
+
+	return &cloudwatchlogs.PutRetentionPolicyOutput{}, nil
+}
+
+func (s *groupStub) DescribeLogGroups(input *cloudwatchlogs.DescribeLogGroupsInput) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	output := &cloudwatchlogs.DescribeLogGroupsOutput{}
+
+	for name, v := range s.groups {
+		if strings.HasPrefix(name, aws.StringValue(input.LogGroupNamePrefix)) {
+			output.LogGroups = append(output.LogGroups, v)
+		}
+	}
+
+	return output, nil
+}
+
+func (s *groupStub) ListTagsLogGroup(input *cloudwatchlogs.ListTagsLogGroupInput) (*cloudwatchlogs.ListTagsLogGroupOutput, error) {
+	return &cloudwatchlogs.ListTagsLogGroupOutput{Tags: s.tags[aws.StringValue(input.LogGroupName)]}, nil
+}
+
+func (s *groupStub) DeleteLogGroup(input *cloudwatchlogs.DeleteLogGroupInput) (*cloudwatchlogs.DeleteLogGroupOutput, error) {
+	name := aws.StringValue(input.LogGroupName)
+
+	if _, ok := s.groups[name]; !ok {
+		return nil, awserr.New(cloudwatchlogs.ErrCodeResourceNotFoundException, "The specified log group does not exist", nil)
+	}
+
+	delete(s.groups, name)
+	delete(s.tags, name)
+
+	return &cloudwatchlogs.DeleteLogGroupOutput{}, nil
+}
+
+func TestGroupCRUD(t *testing.T) {
+	ctx := context.Background()
+	stub := newGroupStub()
+	client := fake.Client()
+	client.LogsConn = fake.ServiceV1(cloudwatchlogs.New, stub).(*cloudwatchlogs.CloudWatchLogs)
+	r := tflogs.ResourceGroup()
+
+	d := fake.ResourceData(t, r, map[string]interface{}{
+		"name":              "test",
+		"retention_in_days": 7,
+		"tags": map[string]interface{}{
+			"key1": "value1",
+		},
+	})
+
+	if diags := fake.Create(ctx, r, d, client); diags.HasError() {
+		t.Fatalf("unexpected error creating: %v", diags)
+	}
+
+	if got, want := d.Id(), "test"; got != want {
+		t.Errorf("id: got %q, expected %q", got, want)
+	}
+
+	if got, want := d.Get("arn").(string), "arn:aws:logs:us-west-2:123456789012:log-group:test"; got != want {
+		t.Errorf("arn: got %q, expected %q", got, want)
+	}
+
+	if got, want := d.Get("retention_in_days").(int), 7; got != want {
+		t.Errorf("retention_in_days: got %d, expected %d", got, want)
+	}
+
+	if got, want := d.Get("tags_all.key1").(string), "value1"; got != want {
+		t.Errorf("tags_all.key1: got %q, expected %q", got, want)
+	}
+
+	if diags := fake.Delete(ctx, r, d, client); diags.HasError() {
+		t.Fatalf("unexpected error deleting: %v", diags)
+	}
+
+	if diags := fake.Read(ctx, r, d, client); diags.HasError() {
+		t.Fatalf("unexpected error reading: %v", diags)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected resource to be removed from state, got %q", d.Id())
+	}
+}
+
+func TestGroupCreate_alreadyExists(t *testing.T) {
+	ctx := context.Background()
+	stub := newGroupStub()
+	client := fake.Client()
+	client.LogsConn = fake.ServiceV1(cloudwatchlogs.New, stub).(*cloudwatchlogs.CloudWatchLogs)
+	r := tflogs.ResourceGroup()
+
+	stub.groups["test"] = &cloudwatchlogs.LogGroup{LogGroupName: aws.String("test")}
+
+	d := fake.ResourceData(t, r, map[string]interface{}{
+		"name": "test",
+	})
+
+	if diags := fake.Create(ctx, r, d, client); !diags.HasError() {
+		t.Fatal("expected error creating existing log group")
+	}
+}
@@ -52,6 +52,22 @@ const (
 	EventSubscriptionStatusModifying = "modifying"
 )
 
+const (
+	ExportTaskStatusCanceled   = "CANCELED"
+	ExportTaskStatusCanceling  = "CANCELING"
+	ExportTaskStatusComplete   = "COMPLETE"
+	ExportTaskStatusFailed     = "FAILED"
+	ExportTaskStatusInProgress = "IN_PROGRESS"
+	ExportTaskStatusStarting   = "STARTING"
+)
+
+const (
+	ReservedInstanceStateActive         = "active"
+	ReservedInstanceStatePaymentFailed  = "payment-failed"
+	ReservedInstanceStatePaymentPending = "payment-pending"
+	ReservedInstanceStateRetired        = "retired"
+)
+
 const (
 	EngineAurora           = "aurora"
 	EngineAuroraMySQL      = "aurora-mysql"
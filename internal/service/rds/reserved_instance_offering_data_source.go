@@ -0,0 +1,120 @@
+package rds
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	ReservedInstanceOfferingTypeAllUpfront     = "All Upfront"
+	ReservedInstanceOfferingTypeNoUpfront      = "No Upfront"
+	ReservedInstanceOfferingTypePartialUpfront = "Partial Upfront"
+)
+
+func ReservedInstanceOfferingType_Values() []string {
+	return []string{
+		ReservedInstanceOfferingTypeAllUpfront,
+		ReservedInstanceOfferingTypeNoUpfront,
+		ReservedInstanceOfferingTypePartialUpfront,
+	}
+}
+
+func DataSourceReservedInstanceOffering() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceReservedInstanceOfferingRead,
+
+		Schema: map[string]*schema.Schema{
+			"currency_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_instance_class": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"duration": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice([]int{31536000, 94608000}),
+			},
+			"fixed_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"multi_az": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"offering_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"offering_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(ReservedInstanceOfferingType_Values(), false),
+			},
+			"product_description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceReservedInstanceOfferingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	productDescription := d.Get("product_description").(string)
+	input := &rds.DescribeReservedDBInstancesOfferingsInput{
+		DBInstanceClass:    aws.String(d.Get("db_instance_class").(string)),
+		Duration:           aws.String(strconv.Itoa(d.Get("duration").(int))),
+		MultiAZ:            aws.Bool(d.Get("multi_az").(bool)),
+		OfferingType:       aws.String(d.Get("offering_type").(string)),
+		ProductDescription: aws.String(productDescription),
+	}
+
+	output, err := findReservedDBInstancesOfferings(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("reading RDS Reserved Instance Offerings: %w", err)
+	}
+
+	// The product description filter matches any offering containing the value.
+	var offerings []*rds.ReservedDBInstancesOffering
+
+	for _, v := range output {
+		if aws.StringValue(v.ProductDescription) == productDescription {
+			offerings = append(offerings, v)
+		}
+	}
+
+	if len(offerings) == 0 {
+		return fmt.Errorf("reading RDS Reserved Instance Offering: %w", tfresource.NewEmptyResultError(input))
+	}
+
+	if count := len(offerings); count > 1 {
+		return fmt.Errorf("reading RDS Reserved Instance Offering: %w", tfresource.NewTooManyResultsError(count, input))
+	}
+
+	offering := offerings[0]
+
+	d.SetId(aws.StringValue(offering.ReservedDBInstancesOfferingId))
+	d.Set("currency_code", offering.CurrencyCode)
+	d.Set("db_instance_class", offering.DBInstanceClass)
+	d.Set("duration", offering.Duration)
+	d.Set("fixed_price", offering.FixedPrice)
+	d.Set("multi_az", offering.MultiAZ)
+	d.Set("offering_id", offering.ReservedDBInstancesOfferingId)
+	d.Set("offering_type", offering.OfferingType)
+	d.Set("product_description", offering.ProductDescription)
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package rds_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+)
+
+func TestAccRDSReservedInstance_basic(t *testing.T) {
+	// Purchasing a reservation is billed and cannot be undone.
+	if os.Getenv("RUN_RDS_RESERVED_INSTANCE_TESTS") != "true" {
+		t.Skip("Environment variable RUN_RDS_RESERVED_INSTANCE_TESTS is not set to true")
+	}
+
+	var reservation rds.ReservedDBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_reserved_instance.test"
+	dataSourceName := "data.aws_rds_reserved_instance_offering.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReservedInstanceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReservedInstanceExists(resourceName, &reservation),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rds", regexp.MustCompile(`ri:.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "currency_code", dataSourceName, "currency_code"),
+					resource.TestCheckResourceAttrPair(resourceName, "db_instance_class", dataSourceName, "db_instance_class"),
+					resource.TestCheckResourceAttrPair(resourceName, "duration", dataSourceName, "duration"),
+					resource.TestCheckResourceAttrPair(resourceName, "fixed_price", dataSourceName, "fixed_price"),
+					resource.TestCheckResourceAttr(resourceName, "instance_count", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "lease_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "multi_az", dataSourceName, "multi_az"),
+					resource.TestCheckResourceAttrPair(resourceName, "offering_id", dataSourceName, "offering_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "offering_type", dataSourceName, "offering_type"),
+					resource.TestCheckResourceAttrPair(resourceName, "product_description", dataSourceName, "product_description"),
+					resource.TestCheckResourceAttr(resourceName, "reservation_id", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, "state", tfrds.ReservedInstanceStateActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckReservedInstanceExists(n string, v *rds.ReservedDBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RDS Reserved Instance ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+		output, err := tfrds.FindReservedDBInstanceByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccReservedInstanceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_rds_reserved_instance_offering" "test" {
+  db_instance_class   = "db.t2.micro"
+  duration            = 31536000
+  multi_az            = false
+  offering_type       = "All Upfront"
+  product_description = "mysql"
+}
+
+resource "aws_rds_reserved_instance" "test" {
+  offering_id    = data.aws_rds_reserved_instance_offering.test.offering_id
+  reservation_id = %[1]q
+  instance_count = 1
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
@@ -2,13 +2,16 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -333,3 +336,61 @@ func waitDBProxyUpdated(conn *rds.RDS, name string, timeout time.Duration) (*rds
 
 	return nil, err
 }
+
+func waitExportTaskCompleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.ExportTask, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ExportTaskStatusStarting, ExportTaskStatusInProgress},
+		Target:     []string{ExportTaskStatusComplete},
+		Refresh:    statusExportTask(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.ExportTask); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureCause)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitExportTaskCanceled(conn *rds.RDS, id string, timeout time.Duration) (*rds.ExportTask, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ExportTaskStatusStarting, ExportTaskStatusInProgress, ExportTaskStatusCanceling},
+		Target:     []string{ExportTaskStatusCanceled, ExportTaskStatusComplete, ExportTaskStatusFailed},
+		Refresh:    statusExportTask(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.ExportTask); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReservedDBInstanceCreated(conn *rds.RDS, id string, timeout time.Duration) (*rds.ReservedDBInstance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ReservedInstanceStatePaymentPending},
+		Target:     []string{ReservedInstanceStateActive},
+		Refresh:    statusReservedDBInstance(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.ReservedDBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,244 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+const (
+	// objectChecksumMultipartThreshold is the size above which objects uploaded with a checksum algorithm
+	// are uploaded in multiple parts. It is the maximum size of an object uploaded in a single PUT operation.
+	objectChecksumMultipartThreshold = 5 * 1024 * 1024 * 1024
+	// objectChecksumPartSize is the default part size of objects uploaded in multiple parts with a checksum algorithm.
+	objectChecksumPartSize = 100 * 1024 * 1024
+)
+
+// objectChecksumAttributes maps checksum algorithms to the corresponding computed attribute.
+var objectChecksumAttributes = map[string]string{
+	s3.ChecksumAlgorithmCrc32:  "checksum_crc32",
+	s3.ChecksumAlgorithmCrc32c: "checksum_crc32c",
+	s3.ChecksumAlgorithmSha1:   "checksum_sha1",
+	s3.ChecksumAlgorithmSha256: "checksum_sha256",
+}
+
+func newChecksumHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		return crc32.NewIEEE(), nil
+	case s3.ChecksumAlgorithmCrc32c:
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli)), nil
+	case s3.ChecksumAlgorithmSha1:
+		return sha1.New(), nil
+	case s3.ChecksumAlgorithmSha256:
+		return sha256.New(), nil
+	}
+
+	return nil, fmt.Errorf("unsupported checksum algorithm: %s", algorithm)
+}
+
+// objectChecksumPartSizeFor returns the part size used to upload an object of the specified size with a checksum algorithm.
+// Zero is returned if the object is uploaded in a single part.
+func objectChecksumPartSizeFor(size int64) int64 {
+	if size <= objectChecksumMultipartThreshold {
+		return 0
+	}
+
+	partSize := int64(objectChecksumPartSize)
+
+	if size/partSize >= s3manager.MaxUploadParts {
+		partSize = size/s3manager.MaxUploadParts + 1
+	}
+
+	return partSize
+}
+
+// objectChecksum returns the checksum that S3 reports for an object with the specified content
+// uploaded using the specified algorithm and part size.
+// For objects uploaded in a single part (partSize is zero) this is the base64-encoded checksum of the content.
+// For objects uploaded in multiple parts this is the base64-encoded checksum of the concatenated part checksums,
+// followed by a hyphen and the number of parts.
+func objectChecksum(r io.ReadSeeker, algorithm string, partSize int64) (string, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	h, err := newChecksumHash(algorithm)
+
+	if err != nil {
+		return "", err
+	}
+
+	if partSize <= 0 {
+		if _, err := io.Copy(h, r); err != nil {
+			return "", err
+		}
+
+		return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+	}
+
+	var parts int
+
+	for {
+		ph, _ := newChecksumHash(algorithm)
+		n, err := io.CopyN(ph, r, partSize)
+
+		if n > 0 {
+			h.Write(ph.Sum(nil))
+			parts++
+		}
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(h.Sum(nil)), parts), nil
+}
+
+// setUploadInputChecksum sets the precomputed checksum member of an upload input.
+func setUploadInputChecksum(input *s3manager.UploadInput, algorithm, checksum string) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		input.ChecksumCRC32 = aws.String(checksum)
+	case s3.ChecksumAlgorithmCrc32c:
+		input.ChecksumCRC32C = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha1:
+		input.ChecksumSHA1 = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha256:
+		input.ChecksumSHA256 = aws.String(checksum)
+	}
+}
+
+// uploadObjectMultipartWithChecksum uploads an object in parts of the specified size, sending the checksum
+// computed with the specified algorithm for each part.
+// s3manager.Uploader does not compute part checksums, which S3 requires when a checksum algorithm is specified.
+func uploadObjectMultipartWithChecksum(conn *s3.S3, input *s3manager.UploadInput, body io.ReadSeeker, algorithm string, partSize int64) error {
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	createInput := &s3.CreateMultipartUploadInput{}
+	awsutil.Copy(createInput, input)
+	createInput.ChecksumAlgorithm = aws.String(algorithm)
+
+	output, err := conn.CreateMultipartUpload(createInput)
+
+	if err != nil {
+		return fmt.Errorf("creating multipart upload: %w", err)
+	}
+
+	uploadID := aws.StringValue(output.UploadId)
+	var parts []*s3.CompletedPart
+
+	err = func() error {
+		buf := make([]byte, partSize)
+
+		for partNumber := int64(1); ; partNumber++ {
+			n, err := io.ReadFull(body, buf)
+
+			if err == io.EOF {
+				return nil
+			}
+
+			if err != nil && err != io.ErrUnexpectedEOF {
+				return err
+			}
+
+			h, _ := newChecksumHash(algorithm)
+			h.Write(buf[:n])
+			checksum := base64.StdEncoding.EncodeToString(h.Sum(nil))
+
+			partInput := &s3.UploadPartInput{
+				Body:                 bytes.NewReader(buf[:n]),
+				Bucket:               input.Bucket,
+				ChecksumAlgorithm:    aws.String(algorithm),
+				ExpectedBucketOwner:  input.ExpectedBucketOwner,
+				Key:                  input.Key,
+				PartNumber:           aws.Int64(partNumber),
+				RequestPayer:         input.RequestPayer,
+				SSECustomerAlgorithm: input.SSECustomerAlgorithm,
+				SSECustomerKey:       input.SSECustomerKey,
+				SSECustomerKeyMD5:    input.SSECustomerKeyMD5,
+				UploadId:             aws.String(uploadID),
+			}
+			part := &s3.CompletedPart{PartNumber: aws.Int64(partNumber)}
+
+			switch algorithm {
+			case s3.ChecksumAlgorithmCrc32:
+				partInput.ChecksumCRC32 = aws.String(checksum)
+				part.ChecksumCRC32 = aws.String(checksum)
+			case s3.ChecksumAlgorithmCrc32c:
+				partInput.ChecksumCRC32C = aws.String(checksum)
+				part.ChecksumCRC32C = aws.String(checksum)
+			case s3.ChecksumAlgorithmSha1:
+				partInput.ChecksumSHA1 = aws.String(checksum)
+				part.ChecksumSHA1 = aws.String(checksum)
+			case s3.ChecksumAlgorithmSha256:
+				partInput.ChecksumSHA256 = aws.String(checksum)
+				part.ChecksumSHA256 = aws.String(checksum)
+			}
+
+			partOutput, err := conn.UploadPart(partInput)
+
+			if err != nil {
+				return fmt.Errorf("uploading part %d: %w", partNumber, err)
+			}
+
+			part.ETag = partOutput.ETag
+			parts = append(parts, part)
+
+			if n < len(buf) {
+				return nil
+			}
+		}
+	}()
+
+	if err == nil {
+		_, err = conn.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+			Bucket:              input.Bucket,
+			ExpectedBucketOwner: input.ExpectedBucketOwner,
+			Key:                 input.Key,
+			MultipartUpload:     &s3.CompletedMultipartUpload{Parts: parts},
+			RequestPayer:        input.RequestPayer,
+			UploadId:            aws.String(uploadID),
+		})
+
+		if err != nil {
+			err = fmt.Errorf("completing multipart upload: %w", err)
+		}
+	}
+
+	if err != nil {
+		_, abortErr := conn.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+			Bucket:              input.Bucket,
+			ExpectedBucketOwner: input.ExpectedBucketOwner,
+			Key:                 input.Key,
+			RequestPayer:        input.RequestPayer,
+			UploadId:            aws.String(uploadID),
+		})
+
+		if abortErr != nil {
+			log.Printf("[WARN] Error aborting S3 multipart upload (%s): %s", uploadID, abortErr)
+		}
+
+		return err
+	}
+
+	return nil
+}
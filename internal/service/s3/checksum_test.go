@@ -0,0 +1,142 @@
+package s3
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestObjectChecksum(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Content   string
+		Algorithm string
+		PartSize  int64
+		Expected  string
+		ExpectErr bool
+	}{
+		{
+			Name:      "empty SHA256",
+			Content:   "",
+			Algorithm: s3.ChecksumAlgorithmSha256,
+			Expected:  "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=",
+		},
+		{
+			Name:      "CRC32",
+			Content:   "hello",
+			Algorithm: s3.ChecksumAlgorithmCrc32,
+			Expected:  "NhCmhg==",
+		},
+		{
+			Name:      "CRC32C",
+			Content:   "hello",
+			Algorithm: s3.ChecksumAlgorithmCrc32c,
+			Expected:  "mnG7TA==",
+		},
+		{
+			Name:      "SHA1",
+			Content:   "hello",
+			Algorithm: s3.ChecksumAlgorithmSha1,
+			Expected:  "qvTGHdzF6KLavt4PO0gs2a6pQ00=",
+		},
+		{
+			Name:      "SHA256",
+			Content:   "hello",
+			Algorithm: s3.ChecksumAlgorithmSha256,
+			Expected:  "LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ=",
+		},
+		{
+			Name:      "multipart CRC32",
+			Content:   "hello world",
+			Algorithm: s3.ChecksumAlgorithmCrc32,
+			PartSize:  4,
+			Expected:  "i9HLOQ==-3",
+		},
+		{
+			Name:      "multipart SHA256",
+			Content:   "hello world",
+			Algorithm: s3.ChecksumAlgorithmSha256,
+			PartSize:  4,
+			Expected:  "J+iaQZsQ9GMOk85VB3k7HBTmmqC78J86d3OixvpestM=-3",
+		},
+		{
+			Name:      "multipart SHA256 exact parts",
+			Content:   "hello wo",
+			Algorithm: s3.ChecksumAlgorithmSha256,
+			PartSize:  4,
+			Expected:  "d7zJzOAmez23i+tTcFtAxeOJc8e9PkpuhzpYOndGhOE=-2",
+		},
+		{
+			Name:      "unsupported algorithm",
+			Content:   "hello",
+			Algorithm: "MD5",
+			ExpectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := bytes.NewReader([]byte(testCase.Content))
+
+			// Start from a non-zero offset to verify that the reader is rewound.
+			r.Seek(1, io.SeekStart)
+
+			got, err := objectChecksum(r, testCase.Algorithm, testCase.PartSize)
+
+			if testCase.ExpectErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestObjectChecksumPartSizeFor(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Size     int64
+		Expected int64
+	}{
+		{
+			Name:     "empty",
+			Size:     0,
+			Expected: 0,
+		},
+		{
+			Name:     "single part maximum",
+			Size:     objectChecksumMultipartThreshold,
+			Expected: 0,
+		},
+		{
+			Name:     "multipart",
+			Size:     objectChecksumMultipartThreshold + 1,
+			Expected: objectChecksumPartSize,
+		},
+		{
+			Name:     "too many parts",
+			Size:     objectChecksumPartSize * 10000,
+			Expected: objectChecksumPartSize + 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := objectChecksumPartSizeFor(testCase.Size); got != testCase.Expected {
+				t.Errorf("got %d, expected %d", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,491 @@
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	directorySyncDefaultConcurrency = 10
+)
+
+func ResourceDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDirectorySyncCreate,
+		Read:   resourceDirectorySyncRead,
+		Update: resourceDirectorySyncUpdate,
+		Delete: resourceDirectorySyncDelete,
+
+		CustomizeDiff: resourceDirectorySyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      directorySyncDefaultConcurrency,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"include": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"manifest_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceDirectorySyncCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	id := bucket + "/" + keyPrefix
+
+	manifest, err := directorySyncManifest(d)
+
+	if err != nil {
+		return err
+	}
+
+	if err := directorySyncUpload(conn, d, manifest.keys()); err != nil {
+		return fmt.Errorf("error syncing S3 Directory Sync (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("files", map[string]string(manifest))
+	d.Set("manifest_hash", manifest.hash())
+
+	return resourceDirectorySyncRead(d, meta)
+}
+
+func resourceDirectorySyncRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	remote := make(map[string]bool)
+
+	err := conn.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(keyPrefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Contents {
+			remote[strings.TrimPrefix(aws.StringValue(v.Key), keyPrefix)] = true
+		}
+
+		return !lastPage
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Directory Sync (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Bucket (%s) objects: %w", bucket, err)
+	}
+
+	// Files deleted out of band are removed from the manifest so that they are uploaded again.
+	manifest := directoryManifest(aws.StringValueMap(flex.ExpandStringMap(d.Get("files").(map[string]interface{}))))
+
+	for k := range manifest {
+		if !remote[k] {
+			log.Printf("[WARN] S3 Directory Sync (%s) object (%s) not found, removing from manifest", d.Id(), keyPrefix+k)
+			delete(manifest, k)
+		}
+	}
+
+	d.Set("files", map[string]string(manifest))
+	d.Set("manifest_hash", manifest.hash())
+
+	return nil
+}
+
+func resourceDirectorySyncUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	manifest, err := directorySyncManifest(d)
+
+	if err != nil {
+		return err
+	}
+
+	o, _ := d.GetChange("files")
+	old := aws.StringValueMap(flex.ExpandStringMap(o.(map[string]interface{})))
+
+	// Changes to object properties require all files to be uploaded again.
+	uploadAll := d.HasChanges("cache_control", "content_types")
+	var uploads, deletes []string
+
+	for _, k := range manifest.keys() {
+		if uploadAll || old[k] != manifest[k] {
+			uploads = append(uploads, k)
+		}
+	}
+
+	for k := range old {
+		if _, ok := manifest[k]; !ok {
+			deletes = append(deletes, k)
+		}
+	}
+
+	// Keep the previous manifest if the sync fails so that it is retried on the next apply.
+	d.Partial(true)
+
+	if err := directorySyncUpload(conn, d, uploads); err != nil {
+		return fmt.Errorf("error syncing S3 Directory Sync (%s): %w", d.Id(), err)
+	}
+
+	if err := directorySyncDelete(conn, d.Get("bucket").(string), d.Get("key_prefix").(string), deletes); err != nil {
+		return fmt.Errorf("error syncing S3 Directory Sync (%s): %w", d.Id(), err)
+	}
+
+	d.Partial(false)
+
+	d.Set("files", map[string]string(manifest))
+	d.Set("manifest_hash", manifest.hash())
+
+	return resourceDirectorySyncRead(d, meta)
+}
+
+func resourceDirectorySyncDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	var keys []string
+
+	for k := range d.Get("files").(map[string]interface{}) {
+		keys = append(keys, k)
+	}
+
+	if err := directorySyncDelete(conn, d.Get("bucket").(string), d.Get("key_prefix").(string), keys); err != nil {
+		return fmt.Errorf("error deleting S3 Directory Sync (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceDirectorySyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"exclude", "include", "source"} {
+		if !d.NewValueKnown(key) {
+			if err := d.SetNewComputed("files"); err != nil {
+				return err
+			}
+
+			return d.SetNewComputed("manifest_hash")
+		}
+	}
+
+	manifest, err := newDirectoryManifest(d.Get("source").(string), aws.StringValueSlice(flex.ExpandStringSet(d.Get("include").(*schema.Set))), aws.StringValueSlice(flex.ExpandStringSet(d.Get("exclude").(*schema.Set))))
+
+	if err != nil {
+		return err
+	}
+
+	if hash := manifest.hash(); hash != d.Get("manifest_hash").(string) {
+		if err := d.SetNew("files", map[string]string(manifest)); err != nil {
+			return err
+		}
+
+		return d.SetNew("manifest_hash", hash)
+	}
+
+	return nil
+}
+
+// directoryManifest maps the slash-separated paths of a directory's files, relative to the directory,
+// to the hex-encoded SHA-256 digest of their contents.
+type directoryManifest map[string]string
+
+// newDirectoryManifest returns the manifest of the files in the specified directory that match any of the include patterns
+// (or all files if there are none) and none of the exclude patterns.
+func newDirectoryManifest(source string, include, exclude []string) (directoryManifest, error) {
+	root, err := homedir.Expand(source)
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding homedir in source (%s): %w", source, err)
+	}
+
+	manifest := make(directoryManifest)
+
+	err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+
+		if err != nil {
+			return err
+		}
+
+		name := filepath.ToSlash(rel)
+
+		if ok, err := matchDirectorySyncPatterns(name, include, exclude); !ok || err != nil {
+			return err
+		}
+
+		checksum, err := fileSHA256(path)
+
+		if err != nil {
+			return err
+		}
+
+		manifest[name] = checksum
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading S3 Directory Sync source (%s): %w", source, err)
+	}
+
+	return manifest, nil
+}
+
+func (m directoryManifest) keys() []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// hash returns a digest of the manifest that changes whenever a file is added, removed or modified.
+func (m directoryManifest) hash() string {
+	h := sha256.New()
+
+	for _, k := range m.keys() {
+		fmt.Fprintf(h, "%s\x00%s\n", k, m[k])
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func matchDirectorySyncPatterns(name string, include, exclude []string) (bool, error) {
+	for _, pattern := range exclude {
+		if ok, err := matchGlob(pattern, name); ok || err != nil {
+			return false, err
+		}
+	}
+
+	if len(include) == 0 {
+		return true, nil
+	}
+
+	for _, pattern := range include {
+		if ok, err := matchGlob(pattern, name); ok || err != nil {
+			return ok, err
+		}
+	}
+
+	return false, nil
+}
+
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close()
+
+	h := sha256.New()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// directoryContentType returns the content type of the specified file, using the configured extension mappings
+// and then the system's MIME types. An empty string is returned if the content type is unknown.
+func directoryContentType(name string, contentTypes map[string]string) string {
+	ext := strings.ToLower(path.Ext(name))
+
+	if ext == "" {
+		return ""
+	}
+
+	for k, v := range contentTypes {
+		if strings.ToLower(strings.TrimPrefix(k, ".")) == ext[1:] {
+			return v
+		}
+	}
+
+	return mime.TypeByExtension(ext)
+}
+
+func directorySyncManifest(d *schema.ResourceData) (directoryManifest, error) {
+	return newDirectoryManifest(d.Get("source").(string), aws.StringValueSlice(flex.ExpandStringSet(d.Get("include").(*schema.Set))), aws.StringValueSlice(flex.ExpandStringSet(d.Get("exclude").(*schema.Set))))
+}
+
+// directorySyncUpload uploads the specified files, using at most the configured number of concurrent uploads.
+func directorySyncUpload(conn *s3.S3, d *schema.ResourceData, names []string) error {
+	root, err := homedir.Expand(d.Get("source").(string))
+
+	if err != nil {
+		return err
+	}
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	cacheControl := d.Get("cache_control").(string)
+	contentTypes := aws.StringValueMap(flex.ExpandStringMap(d.Get("content_types").(map[string]interface{})))
+	uploader := s3manager.NewUploaderWithClient(conn)
+
+	upload := func(name string) error {
+		f, err := os.Open(filepath.Join(root, filepath.FromSlash(name)))
+
+		if err != nil {
+			return err
+		}
+
+		defer f.Close()
+
+		input := &s3manager.UploadInput{
+			Body:   f,
+			Bucket: aws.String(bucket),
+			Key:    aws.String(keyPrefix + name),
+		}
+
+		if cacheControl != "" {
+			input.CacheControl = aws.String(cacheControl)
+		}
+
+		if v := directoryContentType(name, contentTypes); v != "" {
+			input.ContentType = aws.String(v)
+		}
+
+		if _, err := uploader.Upload(input); err != nil {
+			return fmt.Errorf("uploading S3 Bucket (%s) object (%s): %w", bucket, keyPrefix+name, err)
+		}
+
+		return nil
+	}
+
+	work := make(chan string)
+	var mu sync.Mutex
+	var errs *multierror.Error
+	var wg sync.WaitGroup
+
+	for i := 0; i < d.Get("concurrency").(int); i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for name := range work {
+				if err := upload(name); err != nil {
+					mu.Lock()
+					errs = multierror.Append(errs, err)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for _, name := range names {
+		work <- name
+	}
+
+	close(work)
+	wg.Wait()
+
+	return errs.ErrorOrNil()
+}
+
+func directorySyncDelete(conn *s3.S3, bucket, keyPrefix string, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	iterator := &s3manager.DeleteObjectsIterator{}
+
+	for _, name := range names {
+		iterator.Objects = append(iterator.Objects, s3manager.BatchDeleteObject{
+			Object: &s3.DeleteObjectInput{
+				Bucket: aws.String(bucket),
+				Key:    aws.String(keyPrefix + name),
+			},
+		})
+	}
+
+	// Objects that no longer exist are not reported as errors by DeleteObjects.
+	return s3manager.NewBatchDeleteWithClient(conn).Delete(aws.BackgroundContext(), iterator)
+}
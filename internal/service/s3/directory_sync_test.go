@@ -0,0 +1,341 @@
+package s3_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := testAccDirectorySyncCreateTempDir(t, map[string]string{
+		"index.html":     "<html></html>",
+		"css/style.css":  "body {}",
+		"js/app.js":      "console.log('hello');",
+		"data/file.blob": "0123456789",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "concurrency", "10"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "files.index.html", "b633a587c652d02386c4f16f8c6f6aab7352d97f16367c3c40576214372dd628"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_hash"),
+					testAccCheckDirectorySyncObjectContentType(rName, "index.html", "text/html; charset=utf-8"),
+					testAccCheckDirectorySyncObjectContentType(rName, "css/style.css", "text/css; charset=utf-8"),
+					testAccCheckDirectorySyncObjectContentType(rName, "data/file.blob", "application/x-custom"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_update(t *testing.T) {
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := testAccDirectorySyncCreateTempDir(t, map[string]string{
+		"index.html":    "<html></html>",
+		"css/style.css": "body {}",
+		"js/app.js":     "console.log('hello');",
+	})
+
+	var manifestHash string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_keyPrefix(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					testAccCheckDirectorySyncObjectExists(rName, "site/js/app.js"),
+					resource.TestCheckResourceAttrWith(resourceName, "manifest_hash", func(value string) error {
+						manifestHash = value
+						return nil
+					}),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFiles(t, dir, map[string]string{
+						"index.html": "<html><body></body></html>",
+						"about.html": "<html></html>",
+					})
+
+					if err := os.Remove(filepath.Join(dir, "js", "app.js")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_keyPrefix(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.about.html"),
+					resource.TestCheckNoResourceAttr(resourceName, "files.js/app.js"),
+					resource.TestCheckResourceAttrWith(resourceName, "manifest_hash", func(value string) error {
+						if value == manifestHash {
+							return fmt.Errorf("manifest_hash unchanged: %s", value)
+						}
+						return nil
+					}),
+					testAccCheckDirectorySyncObjectExists(rName, "site/about.html"),
+					testAccCheckDirectorySyncObjectNotExists(rName, "site/js/app.js"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_includeExclude(t *testing.T) {
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := testAccDirectorySyncCreateTempDir(t, map[string]string{
+		"index.html":          "<html></html>",
+		"docs/guide.html":     "<html></html>",
+		"docs/draft/wip.html": "<html></html>",
+		"css/style.css":       "body {}",
+		"README.md":           "# README",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_includeExclude(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.docs/guide.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.css/style.css"),
+					testAccCheckDirectorySyncObjectNotExists(rName, "docs/draft/wip.html"),
+					testAccCheckDirectorySyncObjectNotExists(rName, "README.md"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_objectDeletedOutOfBand(t *testing.T) {
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := testAccDirectorySyncCreateTempDir(t, map[string]string{
+		"index.html": "<html></html>",
+		"error.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					testAccCheckDirectorySyncDeleteObject(rName, "error.html"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					testAccCheckDirectorySyncObjectExists(rName, "error.html"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectorySyncDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_directory_sync" {
+			continue
+		}
+
+		output, err := conn.ListObjectsV2(&s3.ListObjectsV2Input{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Prefix: aws.String(rs.Primary.Attributes["key_prefix"]),
+		})
+
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.Contents) > 0 {
+			return fmt.Errorf("S3 Directory Sync %s still has %d objects", rs.Primary.ID, len(output.Contents))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDirectorySyncObjectExists(bucket, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			return fmt.Errorf("S3 Object (%s/%s): %w", bucket, key, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectNotExists(bucket, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+
+		if err == nil {
+			return fmt.Errorf("S3 Object (%s/%s) still exists", bucket, key)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectContentType(bucket, key, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		output, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			return fmt.Errorf("S3 Object (%s/%s): %w", bucket, key, err)
+		}
+
+		if got := aws.StringValue(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Object (%s/%s) content type is %q, expected %q", bucket, key, got, contentType)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncDeleteObject(bucket, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		_, err := conn.DeleteObject(&s3.DeleteObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+
+		return err
+	}
+}
+
+func testAccDirectorySyncCreateTempDir(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	testAccDirectorySyncWriteFiles(t, dir, files)
+
+	return dir
+}
+
+func testAccDirectorySyncWriteFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccDirectorySyncConfig_basic(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  source = %[2]q
+
+  content_types = {
+    ".blob" = "application/x-custom"
+  }
+}
+`, rName, source)
+}
+
+func testAccDirectorySyncConfig_keyPrefix(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket        = aws_s3_bucket.test.bucket
+  key_prefix    = "site/"
+  source        = %[2]q
+  cache_control = "max-age=300"
+  concurrency   = 2
+}
+`, rName, source)
+}
+
+func testAccDirectorySyncConfig_includeExclude(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  source  = %[2]q
+  include = ["**/*.html", "**/*.css"]
+  exclude = ["docs/draft/**"]
+}
+`, rName, source)
+}
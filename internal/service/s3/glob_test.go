@@ -0,0 +1,54 @@
+package s3
+
+import (
+	"testing"
+)
+
+func TestMatchGlob(t *testing.T) {
+	testCases := []struct {
+		Pattern   string
+		Name      string
+		Expected  bool
+		ExpectErr bool
+	}{
+		{Pattern: "*.html", Name: "index.html", Expected: true},
+		{Pattern: "*.html", Name: "docs/index.html", Expected: false},
+		{Pattern: "docs/*.html", Name: "docs/index.html", Expected: true},
+		{Pattern: "**/*.html", Name: "index.html", Expected: true},
+		{Pattern: "**/*.html", Name: "docs/v1/index.html", Expected: true},
+		{Pattern: "**/*.html", Name: "docs/v1/index.css", Expected: false},
+		{Pattern: "docs/**", Name: "docs/v1/index.html", Expected: true},
+		{Pattern: "docs/**", Name: "assets/index.html", Expected: false},
+		{Pattern: "**/.git/**", Name: ".git/config", Expected: true},
+		{Pattern: "**/.git/**", Name: "vendor/module/.git/HEAD", Expected: true},
+		{Pattern: "**/**/*.js", Name: "a/b/c.js", Expected: true},
+		{Pattern: "a/**/b/*.js", Name: "a/b/c.js", Expected: true},
+		{Pattern: "a/**/b/*.js", Name: "a/x/y/b/c.js", Expected: true},
+		{Pattern: "a/**/b/*.js", Name: "a/x/y/c.js", Expected: false},
+		{Pattern: "index.html", Name: "index.html", Expected: true},
+		{Pattern: "index.htm?", Name: "index.html", Expected: true},
+		{Pattern: "[", Name: "index.html", ExpectErr: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Pattern+" "+testCase.Name, func(t *testing.T) {
+			got, err := matchGlob(testCase.Pattern, testCase.Name)
+
+			if testCase.ExpectErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -70,6 +70,27 @@ func ResourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"checksum_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumAlgorithm_Values(), false),
+			},
+			"checksum_crc32": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_crc32c": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -203,6 +224,11 @@ func resourceObjectRead(d *schema.ResourceData, meta interface{}) error {
 		Key:    aws.String(key),
 	}
 
+	// Checksum retrieval requires kms:Decrypt for SSE-KMS encrypted objects.
+	if _, ok := d.GetOk("checksum_algorithm"); ok {
+		input.ChecksumMode = aws.String(s3.ChecksumModeEnabled)
+	}
+
 	var resp *s3.HeadObjectOutput
 
 	err := resource.Retry(objectCreationTimeout, func() *resource.RetryError {
@@ -239,6 +265,10 @@ func resourceObjectRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("bucket_key_enabled", resp.BucketKeyEnabled)
 	d.Set("cache_control", resp.CacheControl)
+	d.Set("checksum_crc32", resp.ChecksumCRC32)
+	d.Set("checksum_crc32c", resp.ChecksumCRC32C)
+	d.Set("checksum_sha1", resp.ChecksumSHA1)
+	d.Set("checksum_sha256", resp.ChecksumSHA256)
 	d.Set("content_disposition", resp.ContentDisposition)
 	d.Set("content_encoding", resp.ContentEncoding)
 	d.Set("content_language", resp.ContentLanguage)
@@ -424,42 +454,14 @@ func resourceObjectUpload(d *schema.ResourceData, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	var body io.ReadSeeker
-
-	if v, ok := d.GetOk("source"); ok {
-		source := v.(string)
-		path, err := homedir.Expand(source)
-		if err != nil {
-			return fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
-		}
-		file, err := os.Open(path)
-		if err != nil {
-			return fmt.Errorf("Error opening S3 object source (%s): %s", path, err)
-		}
+	body, closeBody, err := objectBody(d.Get("source").(string), d.Get("content").(string), d.Get("content_base64").(string))
 
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
-			}
-		}()
-	} else if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		content := v.(string)
-		// We can't do streaming decoding here (with base64.NewDecoder) because
-		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
-		contentRaw, err := base64.StdEncoding.DecodeString(content)
-		if err != nil {
-			return fmt.Errorf("error decoding content_base64: %s", err)
-		}
-		body = bytes.NewReader(contentRaw)
-	} else {
-		body = bytes.NewReader([]byte{})
+	if err != nil {
+		return err
 	}
 
+	defer closeBody()
+
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
@@ -532,6 +534,44 @@ func resourceObjectUpload(d *schema.ResourceData, meta interface{}) error {
 		input.ObjectLockRetainUntilDate = expandObjectDate(v.(string))
 	}
 
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		algorithm := v.(string)
+
+		size, err := body.Seek(0, io.SeekEnd)
+
+		if err != nil {
+			return fmt.Errorf("error reading S3 object body: %w", err)
+		}
+
+		if partSize := objectChecksumPartSizeFor(size); partSize > 0 {
+			if err := uploadObjectMultipartWithChecksum(conn, input, body, algorithm, partSize); err != nil {
+				return fmt.Errorf("Error uploading object to S3 bucket (%s): %s", bucket, err)
+			}
+
+			d.SetId(key)
+
+			return resourceObjectRead(d, meta)
+		}
+
+		checksum, err := objectChecksum(body, algorithm, 0)
+
+		if err != nil {
+			return fmt.Errorf("error computing S3 object %s checksum: %w", algorithm, err)
+		}
+
+		if _, err := body.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("error reading S3 object body: %w", err)
+		}
+
+		input.ChecksumAlgorithm = aws.String(algorithm)
+		setUploadInputChecksum(input, algorithm, checksum)
+
+		// Upload the object in a single part so that the precomputed checksum is sent.
+		if size > uploader.PartSize {
+			uploader.PartSize = size
+		}
+	}
+
 	if _, err := uploader.Upload(input); err != nil {
 		return fmt.Errorf("Error uploading object to S3 bucket (%s): %s", bucket, err)
 	}
@@ -574,21 +614,121 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	if hasObjectContentChanges(d) {
-		return d.SetNewComputed("version_id")
+		for _, key := range []string{"checksum_crc32", "checksum_crc32c", "checksum_sha1", "checksum_sha256", "version_id"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+
+		return nil
 	}
 
-	if d.HasChange("source_hash") {
-		d.SetNewComputed("version_id")
-		d.SetNewComputed("etag")
+	if d.HasChange("source_hash") || objectChecksumChanged(d) {
+		for _, key := range []string{"checksum_crc32", "checksum_crc32c", "checksum_sha1", "checksum_sha256", "etag", "version_id"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
 
+// objectChecksumChanged returns whether the checksum of an existing object's configured content differs from
+// the checksum S3 reports for the object, e.g. after the local source file has been modified.
+func objectChecksumChanged(d *schema.ResourceDiff) bool {
+	if d.Id() == "" {
+		return false
+	}
+
+	algorithm := d.Get("checksum_algorithm").(string)
+
+	if algorithm == "" {
+		return false
+	}
+
+	for _, key := range []string{"content", "content_base64", "source"} {
+		if !d.NewValueKnown(key) {
+			return false
+		}
+	}
+
+	old := d.Get(objectChecksumAttributes[algorithm]).(string)
+
+	if old == "" {
+		return false
+	}
+
+	body, closeBody, err := objectBody(d.Get("source").(string), d.Get("content").(string), d.Get("content_base64").(string))
+
+	if err != nil {
+		log.Printf("[WARN] Unable to compute S3 Object (%s) checksum: %s", d.Id(), err)
+		return false
+	}
+
+	defer closeBody()
+
+	size, err := body.Seek(0, io.SeekEnd)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to compute S3 Object (%s) checksum: %s", d.Id(), err)
+		return false
+	}
+
+	checksum, err := objectChecksum(body, algorithm, objectChecksumPartSizeFor(size))
+
+	if err != nil {
+		log.Printf("[WARN] Unable to compute S3 Object (%s) checksum: %s", d.Id(), err)
+		return false
+	}
+
+	return checksum != old
+}
+
+// objectBody returns the object body specified by the source, content or content_base64 arguments
+// and a function that releases any resources held by it.
+func objectBody(source, content, contentBase64 string) (io.ReadSeeker, func(), error) {
+	if source != "" {
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error opening S3 object source (%s): %s", path, err)
+		}
+
+		return file, func() {
+			err := file.Close()
+			if err != nil {
+				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
+			}
+		}, nil
+	}
+
+	if content != "" {
+		return bytes.NewReader([]byte(content)), func() {}, nil
+	}
+
+	if contentBase64 != "" {
+		// We can't do streaming decoding here (with base64.NewDecoder) because
+		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
+		contentRaw, err := base64.StdEncoding.DecodeString(contentBase64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error decoding content_base64: %s", err)
+		}
+
+		return bytes.NewReader(contentRaw), func() {}, nil
+	}
+
+	return bytes.NewReader([]byte{}), func() {}, nil
+}
+
 func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
 		"cache_control",
+		"checksum_algorithm",
 		"content_base64",
 		"content_disposition",
 		"content_encoding",
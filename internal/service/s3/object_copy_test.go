@@ -41,6 +41,45 @@ func TestAccS3ObjectCopy_basic(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectCopy_checksumAlgorithm(t *testing.T) {
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object_copy.test"
+	key := "HundBegraven"
+	sourceKey := "WshngtnNtnls"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectCopyConfig_checksumAlgorithm(rName1, sourceKey, rName2, key, s3.ChecksumAlgorithmCrc32c),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", s3.ChecksumAlgorithmCrc32c),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", "JUrHHg=="),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+				),
+			},
+			{
+				Config: testAccObjectCopyConfig_checksumAlgorithm(rName1, sourceKey, rName2, key, s3.ChecksumAlgorithmSha1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", s3.ChecksumAlgorithmSha1),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", "jJAmclKRAT/qjnalJrQbfan0ODI="),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3ObjectCopy_BucketKeyEnabled_bucket(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_s3_object_copy.test"
@@ -160,6 +199,32 @@ resource "aws_s3_object_copy" "test" {
 `, rName1, sourceKey, rName2, key)
 }
 
+func testAccObjectCopyConfig_checksumAlgorithm(rName1, sourceKey, rName2, key, algorithm string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "source" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "source" {
+  bucket  = aws_s3_bucket.source.bucket
+  key     = %[2]q
+  content = "Ingen ko på isen"
+}
+
+resource "aws_s3_bucket" "target" {
+  bucket = %[3]q
+}
+
+resource "aws_s3_object_copy" "test" {
+  bucket = aws_s3_bucket.target.bucket
+  key    = %[4]q
+  source = "${aws_s3_bucket.source.bucket}/${aws_s3_object.source.key}"
+
+  checksum_algorithm = %[5]q
+}
+`, rName1, sourceKey, rName2, key, algorithm)
+}
+
 func testAccObjectCopyConfig_bucketKeyEnabledBucket(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -6,5 +6,7 @@ const (
 	errCodeNoSuchAccessPoint            = "NoSuchAccessPoint"
 	errCodeNoSuchAccessPointPolicy      = "NoSuchAccessPointPolicy"
 	errCodeNoSuchAsyncRequest           = "NoSuchAsyncRequest"
+	errCodeNoSuchConfiguration          = "NoSuchConfiguration"
 	errCodeNoSuchMultiRegionAccessPoint = "NoSuchMultiRegionAccessPoint"
+	errCodeNoSuchTagSet                 = "NoSuchTagSet"
 )
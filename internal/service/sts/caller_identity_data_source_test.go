@@ -1,13 +1,75 @@
 package sts_test
 
 import (
+	"context"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
+	tfsts "github.com/hashicorp/terraform-provider-aws/internal/service/sts"
 )
 
+type callerIdentityStub struct {
+	stsiface.STSAPI
+
+	err error
+}
+
+func (s callerIdentityStub) GetCallerIdentity(*sts.GetCallerIdentityInput) (*sts.GetCallerIdentityOutput, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return &sts.GetCallerIdentityOutput{
+		Account: aws.String(fake.AccountID),
+		Arn:     aws.String("arn:aws:iam::" + fake.AccountID + ":user/test"),
+		UserId:  aws.String("AIDACKCEVSQ6C2EXAMPLE"),
+	}, nil
+}
+
+func TestCallerIdentityDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	client := fake.Client()
+	client.STSConn = fake.ServiceV1(sts.New, callerIdentityStub{}).(*sts.STS)
+	r := tfsts.DataSourceCallerIdentity()
+	d := fake.ResourceData(t, r, map[string]interface{}{})
+
+	if diags := fake.Read(ctx, r, d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := d.Id(), fake.AccountID; got != want {
+		t.Errorf("id: got %q, expected %q", got, want)
+	}
+
+	for k, want := range map[string]string{
+		"account_id": fake.AccountID,
+		"arn":        "arn:aws:iam::" + fake.AccountID + ":user/test",
+		"user_id":    "AIDACKCEVSQ6C2EXAMPLE",
+	} {
+		if got := d.Get(k).(string); got != want {
+			t.Errorf("%s: got %q, expected %q", k, got, want)
+		}
+	}
+}
+
+func TestCallerIdentityDataSourceRead_error(t *testing.T) {
+	ctx := context.Background()
+	client := fake.Client()
+	client.STSConn = fake.ServiceV1(sts.New, callerIdentityStub{err: awserr.New("ExpiredToken", "The security token included in the request is expired", nil)}).(*sts.STS)
+	r := tfsts.DataSourceCallerIdentity()
+	d := fake.ResourceData(t, r, map[string]interface{}{})
+
+	if diags := fake.Read(ctx, r, d, client); !diags.HasError() {
+		t.Fatal("expected error")
+	}
+}
+
 func TestAccSTSCallerIdentityDataSource_basic(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
@@ -32,3 +32,26 @@ func CanonicalCIDRBlock(cidr string) string {
 
 	return ipnet.String()
 }
+
+// CIDRBlockContains returns whether or not the first CIDR block contains the second.
+// A CIDR block contains itself.
+func CIDRBlockContains(cidr1, cidr2 string) bool {
+	_, ipnet1, err := net.ParseCIDR(cidr1)
+	if err != nil {
+		return false
+	}
+	_, ipnet2, err := net.ParseCIDR(cidr2)
+	if err != nil {
+		return false
+	}
+
+	ones1, bits1 := ipnet1.Mask.Size()
+	ones2, bits2 := ipnet2.Mask.Size()
+
+	return bits1 == bits2 && ones1 <= ones2 && ipnet1.Contains(ipnet2.IP)
+}
+
+// CIDRBlocksOverlap returns whether or not two CIDR blocks have any addresses in common.
+func CIDRBlocksOverlap(cidr1, cidr2 string) bool {
+	return CIDRBlockContains(cidr1, cidr2) || CIDRBlockContains(cidr2, cidr1)
+}
@@ -41,3 +41,50 @@ func TestCanonicalCIDRBlock(t *testing.T) {
 		}
 	}
 }
+
+func TestCIDRBlockContains(t *testing.T) {
+	for _, ts := range []struct {
+		cidr1    string
+		cidr2    string
+		expected bool
+	}{
+		{"10.0.0.0/16", "10.0.1.0/24", true},
+		{"10.0.0.0/16", "10.0.0.0/16", true},
+		{"10.0.1.0/24", "10.0.0.0/16", false},
+		{"10.0.0.0/16", "10.1.0.0/24", false},
+		{"10.0.0.0/16", "10.0.255.255/32", true},
+		{"0.0.0.0/0", "192.168.0.0/16", true},
+		{"2001:db8::/32", "2001:db8:1::/48", true},
+		{"2001:db8:1::/48", "2001:db8::/32", false},
+		{"::/0", "0.0.0.0/0", false},
+		{"10.0.0.0/16", "", false},
+		{"", "10.0.0.0/16", false},
+	} {
+		got := CIDRBlockContains(ts.cidr1, ts.cidr2)
+		if ts.expected != got {
+			t.Fatalf("CIDRBlockContains(%q, %q) should be: %t", ts.cidr1, ts.cidr2, ts.expected)
+		}
+	}
+}
+
+func TestCIDRBlocksOverlap(t *testing.T) {
+	for _, ts := range []struct {
+		cidr1    string
+		cidr2    string
+		expected bool
+	}{
+		{"10.0.0.0/16", "10.0.1.0/24", true},
+		{"10.0.1.0/24", "10.0.0.0/16", true},
+		{"10.0.0.0/24", "10.0.1.0/24", false},
+		{"10.0.0.0/23", "10.0.1.0/24", true},
+		{"2001:db8::/32", "2001:db8:1::/48", true},
+		{"2001:db8::/48", "2001:db8:1::/48", false},
+		{"10.0.0.0/8", "::/0", false},
+		{"10.0.0.0/8", "10.0.0.0/1234", false},
+	} {
+		got := CIDRBlocksOverlap(ts.cidr1, ts.cidr2)
+		if ts.expected != got {
+			t.Fatalf("CIDRBlocksOverlap(%q, %q) should be: %t", ts.cidr1, ts.cidr2, ts.expected)
+		}
+	}
+}